@@ -99,3 +99,96 @@ func (s *ByteString) Set(b []byte) {
 	s.Value = b
 	s.Length = int32(len(s.Value))
 }
+
+// ByteStringArray represents the array of ByteStrings.
+type ByteStringArray struct {
+	ArraySize   int32
+	ByteStrings []*ByteString
+}
+
+// NewByteStringArray creates a new ByteStringArray from multiple byte slices.
+func NewByteStringArray(bs [][]byte) *ByteStringArray {
+	if bs == nil {
+		return &ByteStringArray{
+			ArraySize: 0,
+		}
+	}
+
+	b := &ByteStringArray{
+		ArraySize: int32(len(bs)),
+	}
+	for _, v := range bs {
+		b.ByteStrings = append(b.ByteStrings, NewByteString(v))
+	}
+
+	return b
+}
+
+// DecodeByteStringArray decodes given bytes into ByteStringArray.
+func DecodeByteStringArray(b []byte) (*ByteStringArray, error) {
+	s := &ByteStringArray{}
+	if err := s.DecodeFromBytes(b); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// DecodeFromBytes decodes given bytes into ByteStringArray.
+func (s *ByteStringArray) DecodeFromBytes(b []byte) error {
+	if len(b) < 4 {
+		return errors.NewErrTooShortToDecode(s, "should be longer than 4 bytes")
+	}
+
+	s.ArraySize = int32(binary.LittleEndian.Uint32(b[:4]))
+	if s.ArraySize <= 0 {
+		return nil
+	}
+
+	var offset = 4
+	for i := 1; i <= int(s.ArraySize); i++ {
+		bs, err := DecodeByteString(b[offset:])
+		if err != nil {
+			return err
+		}
+		s.ByteStrings = append(s.ByteStrings, bs)
+		offset += bs.Len()
+	}
+
+	return nil
+}
+
+// Serialize serializes ByteStringArray into bytes.
+func (s *ByteStringArray) Serialize() ([]byte, error) {
+	b := make([]byte, s.Len())
+	if err := s.SerializeTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// SerializeTo serializes ByteStringArray into bytes.
+func (s *ByteStringArray) SerializeTo(b []byte) error {
+	var offset = 4
+	binary.LittleEndian.PutUint32(b[:4], uint32(s.ArraySize))
+
+	for _, bs := range s.ByteStrings {
+		if err := bs.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += bs.Len()
+	}
+
+	return nil
+}
+
+// Len returns the actual length in int.
+func (s *ByteStringArray) Len() int {
+	l := 4
+	for _, bs := range s.ByteStrings {
+		l += bs.Len()
+	}
+
+	return l
+}
@@ -47,3 +47,34 @@ func TestSerializeByteString(t *testing.T) {
 	}
 	t.Logf("%x", serialized)
 }
+
+func TestByteStringArray(t *testing.T) {
+	serialized := []byte{
+		0x02, 0x00, 0x00, 0x00,
+		0x02, 0x00, 0x00, 0x00, 0xde, 0xad,
+		0x02, 0x00, 0x00, 0x00, 0xbe, 0xef,
+	}
+	a := NewByteStringArray([][]byte{{0xde, 0xad}, {0xbe, 0xef}})
+
+	b, err := a.Serialize()
+	if err != nil {
+		t.Fatalf("Failed to serialize ByteStringArray: %s", err)
+	}
+	if got, want := hex.EncodeToString(b), hex.EncodeToString(serialized); got != want {
+		t.Errorf("Bytes doesn't match. Want: %s, Got: %s", want, got)
+	}
+
+	decoded, err := DecodeByteStringArray(serialized)
+	if err != nil {
+		t.Fatalf("Failed to decode ByteStringArray: %s", err)
+	}
+	if decoded.ArraySize != 2 || len(decoded.ByteStrings) != 2 {
+		t.Fatalf("ArraySize doesn't match. Want: 2, Got: %d", decoded.ArraySize)
+	}
+	if got := hex.EncodeToString(decoded.ByteStrings[1].Get()); got != "beef" {
+		t.Errorf("Value doesn't match. Want: beef, Got: %s", got)
+	}
+	if decoded.Len() != len(serialized) {
+		t.Errorf("Len doesn't match. Want: %d, Got: %d", len(serialized), decoded.Len())
+	}
+}
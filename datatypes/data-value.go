@@ -257,6 +257,33 @@ func (d *DataValue) SetServerPicoSecondsFlag() {
 	d.EncodingMask |= 0x20
 }
 
+// Good checks if the Status of DataValue is Good.
+// The Status is Good if it is omitted in the stream.
+func (d *DataValue) Good() bool {
+	// the severity is encoded in the top two bits.
+	return d.Status&0xc0000000 == 0
+}
+
+// SourceTime returns SourceTimestamp of DataValue.
+// It returns zero time if the SourceTimestamp is omitted in the stream,
+// e.g. TimestampsToReturn is Server or Neither.
+func (d *DataValue) SourceTime() time.Time {
+	if !d.HasSourceTimestamp() {
+		return time.Time{}
+	}
+	return d.SourceTimestamp
+}
+
+// ServerTime returns ServerTimestamp of DataValue.
+// It returns zero time if the ServerTimestamp is omitted in the stream,
+// e.g. TimestampsToReturn is Source or Neither.
+func (d *DataValue) ServerTime() time.Time {
+	if !d.HasServerTimestamp() {
+		return time.Time{}
+	}
+	return d.ServerTimestamp
+}
+
 // DataValueArray represents the DataValueArray.
 type DataValueArray struct {
 	ArraySize  int32
@@ -109,6 +109,55 @@ func TestDataValueLen(t *testing.T) {
 	}
 }
 
+func TestDataValueAccessors(t *testing.T) {
+	ts := time.Date(2018, time.September, 17, 14, 28, 29, 112000000, time.UTC)
+	var cases = []struct {
+		description string
+		bytes       []byte
+		good        bool
+		srcTs       time.Time
+		svrTs       time.Time
+	}{
+		{
+			"timestamps to return: neither",
+			[]byte{0x01, 0x0a, 0x19, 0x04, 0x20, 0x40},
+			true, time.Time{}, time.Time{},
+		},
+		{
+			"timestamps to return: both",
+			[]byte{
+				0x0d, 0x0a, 0xc9, 0x02, 0x20, 0x40, 0x80, 0x3b,
+				0xe8, 0xb3, 0x92, 0x4e, 0xd4, 0x01, 0x80, 0x3b,
+				0xe8, 0xb3, 0x92, 0x4e, 0xd4, 0x01,
+			},
+			true, ts, ts,
+		},
+		{
+			"bad status",
+			// BadNodeIdUnknown
+			[]byte{0x02, 0x00, 0x00, 0x34, 0x80},
+			false, time.Time{}, time.Time{},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.description, func(t *testing.T) {
+			d, err := DecodeDataValue(c.bytes)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := d.Good(); got != c.good {
+				t.Errorf("Good doesn't match. Want: %v, Got: %v", c.good, got)
+			}
+			if got := d.SourceTime(); !got.Equal(c.srcTs) {
+				t.Errorf("SourceTime doesn't match. Want: %v, Got: %v", c.srcTs, got)
+			}
+			if got := d.ServerTime(); !got.Equal(c.svrTs) {
+				t.Errorf("ServerTime doesn't match. Want: %v, Got: %v", c.svrTs, got)
+			}
+		})
+	}
+}
+
 var dataValueArrayTests = []struct {
 	description string
 	bytes       []byte
@@ -4,17 +4,31 @@
 
 package datatypes
 
-import "encoding/binary"
+import (
+	"encoding/binary"
+	"fmt"
+	"sync"
+
+	"github.com/wmnsk/gopcua/errors"
+	"github.com/wmnsk/gopcua/id"
+)
 
 // ExtensionObject is encoded as sequence of bytes prefixed by the NodeId of its DataTypeEncoding
 // and the number of bytes encoded.
 //
+// If EncodingMask is 0x00, the ExtensionObject has no Length and body, which is what
+// NewNullExtensionObject creates.
+//
+// If a type is registered for the TypeID with RegisterExtensionObject, the body is
+// decoded into Value instead of Body.
+//
 // Specification: Part 6, 5.2.2.15
 type ExtensionObject struct {
 	TypeID       *ExpandedNodeID
 	EncodingMask byte
 	Length       int32
 	Body         *ByteString
+	Value        Data
 }
 
 // NewExtensionObject creates a new ExtensionObject.
@@ -29,6 +43,14 @@ func NewExtensionObject(typeID *ExpandedNodeID, mask uint8, body []byte) *Extens
 	return e
 }
 
+// NewNullExtensionObject creates a new ExtensionObject without body.
+func NewNullExtensionObject() *ExtensionObject {
+	return &ExtensionObject{
+		TypeID:       NewExpandedNodeID(false, false, NewTwoByteNodeID(0), "", 0),
+		EncodingMask: 0x00,
+	}
+}
+
 // DecodeExtensionObject decodes given bytes into ExtensionObject.
 func DecodeExtensionObject(b []byte) (*ExtensionObject, error) {
 	e := &ExtensionObject{}
@@ -49,14 +71,43 @@ func (e *ExtensionObject) DecodeFromBytes(b []byte) error {
 	offset := e.TypeID.Len()
 
 	// encoding mask
+	if len(b[offset:]) < 1 {
+		return errors.NewErrTooShortToDecode(e, "should have EncodingMask after TypeID")
+	}
 	e.EncodingMask = b[offset]
 	offset++
+	if e.EncodingMask == 0x00 {
+		return nil
+	}
 
 	// length
+	if len(b[offset:]) < 4 {
+		return errors.NewErrTooShortToDecode(e, "should have Length after EncodingMask")
+	}
 	e.Length = int32(binary.LittleEndian.Uint32(b[offset : offset+4]))
 	offset += 4
 
 	// body
+	if factory := extensionObjectFactory(e.TypeID); factory != nil {
+		if e.Length < 0 {
+			return errors.NewErrInvalidLength(e, fmt.Sprintf("Length should not be negative, got %d", e.Length))
+		}
+		if len(b[offset:]) < int(e.Length) {
+			return errors.NewErrTooShortToDecode(e, "should have the body of Length bytes")
+		}
+		e.Value = factory()
+		if err := e.Value.DecodeFromBytes(b[offset : offset+int(e.Length)]); err != nil {
+			return err
+		}
+
+		// the Value should consume exactly Length bytes, otherwise Len does not
+		// match the decoded bytes and the fields that follow are read at wrong offset.
+		if e.Value.Len() != int(e.Length) {
+			return errors.NewErrInvalidLength(e, fmt.Sprintf("decoded body is %d bytes while Length is %d", e.Value.Len(), e.Length))
+		}
+		return nil
+	}
+
 	e.Body = &ByteString{}
 	if err := e.Body.DecodeFromBytes(b[offset:]); err != nil {
 		return err
@@ -90,12 +141,18 @@ func (e *ExtensionObject) SerializeTo(b []byte) error {
 	// encoding mask
 	b[offset] = e.EncodingMask
 	offset++
+	if e.EncodingMask == 0x00 {
+		return nil
+	}
 
 	// length
 	binary.LittleEndian.PutUint32(b[offset:offset+4], uint32(e.Length))
 	offset += 4
 
 	// body
+	if e.Value != nil {
+		return e.Value.SerializeTo(b[offset:])
+	}
 	if e.Body != nil {
 		if err := e.Body.SerializeTo(b[offset:]); err != nil {
 			return err
@@ -107,13 +164,22 @@ func (e *ExtensionObject) SerializeTo(b []byte) error {
 
 // Len returns the actual length of ExtensionObject in int.
 func (e *ExtensionObject) Len() int {
-	// encoding mask byte + length
-	length := 1 + 4
+	// encoding mask byte
+	length := 1
 
 	if e.TypeID != nil {
 		length += e.TypeID.Len()
 	}
+	if e.EncodingMask == 0x00 {
+		return length
+	}
+
+	// length
+	length += 4
 
+	if e.Value != nil {
+		return length + e.Value.Len()
+	}
 	if e.Body != nil {
 		length += e.Body.Len()
 	}
@@ -121,7 +187,150 @@ func (e *ExtensionObject) Len() int {
 	return length
 }
 
-// SetLength sets the length of Body in Length field.
+// SetLength sets the length of Body, or Value if any, in Length field.
 func (e *ExtensionObject) SetLength() {
+	if e.Value != nil {
+		e.Length = int32(e.Value.Len())
+		return
+	}
 	e.Length = int32(e.Body.Len())
 }
+
+// DataType returns type of Data.
+func (e *ExtensionObject) DataType() uint16 {
+	return id.Structure
+}
+
+// ExtensionObjectArray represents the ExtensionObjectArray.
+type ExtensionObjectArray struct {
+	ArraySize        int32
+	ExtensionObjects []*ExtensionObject
+}
+
+// NewExtensionObjectArray creates a new ExtensionObjectArray from multiple ExtensionObjects.
+func NewExtensionObjectArray(objs []*ExtensionObject) *ExtensionObjectArray {
+	if objs == nil {
+		return &ExtensionObjectArray{
+			ArraySize: 0,
+		}
+	}
+
+	return &ExtensionObjectArray{
+		ArraySize:        int32(len(objs)),
+		ExtensionObjects: objs,
+	}
+}
+
+// DecodeExtensionObjectArray decodes given bytes into ExtensionObjectArray.
+func DecodeExtensionObjectArray(b []byte) (*ExtensionObjectArray, error) {
+	e := &ExtensionObjectArray{}
+	if err := e.DecodeFromBytes(b); err != nil {
+		return nil, err
+	}
+
+	return e, nil
+}
+
+// DecodeFromBytes decodes given bytes into ExtensionObjectArray.
+func (e *ExtensionObjectArray) DecodeFromBytes(b []byte) error {
+	if len(b) < 4 {
+		return errors.NewErrTooShortToDecode(e, "should be longer than 4 bytes")
+	}
+
+	e.ArraySize = int32(binary.LittleEndian.Uint32(b[:4]))
+	if e.ArraySize <= 0 {
+		return nil
+	}
+
+	var offset = 4
+	for i := 1; i <= int(e.ArraySize); i++ {
+		eo, err := DecodeExtensionObject(b[offset:])
+		if err != nil {
+			return err
+		}
+		e.ExtensionObjects = append(e.ExtensionObjects, eo)
+		offset += eo.Len()
+	}
+
+	return nil
+}
+
+// Serialize serializes ExtensionObjectArray into bytes.
+func (e *ExtensionObjectArray) Serialize() ([]byte, error) {
+	b := make([]byte, e.Len())
+	if err := e.SerializeTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// SerializeTo serializes ExtensionObjectArray into bytes.
+func (e *ExtensionObjectArray) SerializeTo(b []byte) error {
+	var offset = 4
+	binary.LittleEndian.PutUint32(b[:4], uint32(e.ArraySize))
+
+	for _, eo := range e.ExtensionObjects {
+		if err := eo.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += eo.Len()
+	}
+
+	return nil
+}
+
+// Len returns the actual length in int.
+func (e *ExtensionObjectArray) Len() int {
+	l := 4
+	for _, eo := range e.ExtensionObjects {
+		l += eo.Len()
+	}
+
+	return l
+}
+
+var extensionObjectTypes = struct {
+	sync.RWMutex
+	factories map[string]func() Data
+}{factories: map[string]func() Data{}}
+
+// RegisterExtensionObject registers the function that creates the Data to decode
+// the body of ExtensionObject with the given TypeID into.
+//
+// This is typically used for the structures defined by the Server, so that the body
+// of such ExtensionObject is available as the registered type in Value.
+// Registering the same TypeID again replaces the previous one.
+func RegisterExtensionObject(typeID NodeID, factory func() Data) {
+	extensionObjectTypes.Lock()
+	defer extensionObjectTypes.Unlock()
+
+	extensionObjectTypes.factories[extensionObjectKey(typeID)] = factory
+}
+
+func extensionObjectFactory(typeID *ExpandedNodeID) func() Data {
+	if typeID == nil || typeID.NodeID == nil {
+		return nil
+	}
+
+	extensionObjectTypes.RLock()
+	defer extensionObjectTypes.RUnlock()
+
+	return extensionObjectTypes.factories[extensionObjectKey(typeID.NodeID)]
+}
+
+// extensionObjectKey returns the key of typeID in the registry.
+// Numeric NodeIDs are identified regardless of the encoding, as the Server
+// may choose any one that fits the value.
+func extensionObjectKey(typeID NodeID) string {
+	switch n := typeID.(type) {
+	case *TwoByteNodeID:
+		return fmt.Sprintf("ns=%d;i=%d", 0, n.Identifier)
+	case *FourByteNodeID:
+		return fmt.Sprintf("ns=%d;i=%d", n.Namespace, n.Identifier)
+	case *NumericNodeID:
+		return fmt.Sprintf("ns=%d;i=%d", n.Namespace, n.Identifier)
+	default:
+		return typeID.String()
+	}
+}
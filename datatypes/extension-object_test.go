@@ -157,3 +157,103 @@ func TestExtensionObjectLen(t *testing.T) {
 		t.Errorf("Len doesn't match. Want: %d, Got: %d", 14, e.Len())
 	}
 }
+
+func TestRegisterExtensionObject(t *testing.T) {
+	// the Server sends FourByteNodeID for the type registered as NumericNodeID.
+	RegisterExtensionObject(NewNumericNodeID(2, 5001), func() Data { return &Float{} })
+
+	b := []byte{
+		// Variant: ExtensionObject
+		0x16,
+		// TypeID
+		0x01, 0x02, 0x89, 0x13,
+		// EncodingMask
+		0x01,
+		// Length
+		0x04, 0x00, 0x00, 0x00,
+		// Body
+		0x00, 0x00, 0x20, 0x40,
+	}
+	v, err := DecodeVariant(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(v.Interface(), NewFloat(2.5)); diff != "" {
+		t.Error(diff)
+	}
+
+	serialized, err := v.Value.Serialize()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(serialized, b[1:]); diff != "" {
+		t.Error(diff)
+	}
+}
+
+func TestRegisteredExtensionObjectInvalidLength(t *testing.T) {
+	RegisterExtensionObject(NewNumericNodeID(2, 5002), func() Data { return &Float{} })
+
+	var cases = []struct {
+		description string
+		b           []byte
+	}{
+		{
+			"longer-than-value",
+			[]byte{
+				// TypeID
+				0x01, 0x02, 0x8a, 0x13,
+				// EncodingMask
+				0x01,
+				// Length: longer than Float
+				0x06, 0x00, 0x00, 0x00,
+				// Body: Float and the trailing bytes unknown to it
+				0x00, 0x00, 0x20, 0x40, 0xde, 0xad,
+			},
+		},
+		{
+			"negative",
+			[]byte{
+				// TypeID
+				0x01, 0x02, 0x8a, 0x13,
+				// EncodingMask
+				0x01,
+				// Length: -1
+				0xff, 0xff, 0xff, 0xff,
+				// Body
+				0x00, 0x00, 0x20, 0x40,
+			},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.description, func(t *testing.T) {
+			if _, err := DecodeExtensionObject(c.b); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
+
+func TestNullExtensionObject(t *testing.T) {
+	b := []byte{
+		// TypeID
+		0x00, 0x00,
+		// EncodingMask
+		0x00,
+	}
+	e, err := DecodeExtensionObject(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(e, NewNullExtensionObject()); diff != "" {
+		t.Error(diff)
+	}
+
+	serialized, err := NewNullExtensionObject().Serialize()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(serialized, b); diff != "" {
+		t.Error(diff)
+	}
+}
@@ -0,0 +1,49 @@
+// Copyright 2018 gopcua authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package datatypes
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/wmnsk/gopcua/errors"
+)
+
+// ValidateIndexRange checks if s is a valid IndexRange, i.e. NumericRange in string,
+// which is used in ReadValueID and WriteValue to access a part of an array value.
+// The types which have IndexRange fail to serialize if it is invalid.
+//
+// Each dimension is either a single index like "1" or a range like "0:9", in which the
+// first index should be less than the second one. The dimensions of a multidimensional
+// array are separated by commas, like "0:9,0:3".
+// An empty string is valid, which means the whole value.
+//
+// Specification: Part 4, 7.22
+func ValidateIndexRange(s string) error {
+	if s == "" {
+		return nil
+	}
+
+	for _, dim := range strings.Split(s, ",") {
+		bounds := strings.Split(dim, ":")
+		if len(bounds) > 2 {
+			return errors.Errorf("invalid IndexRange %q: too many bounds in %q", s, dim)
+		}
+
+		var idx []uint64
+		for _, b := range bounds {
+			n, err := strconv.ParseUint(b, 10, 32)
+			if err != nil {
+				return errors.Errorf("invalid IndexRange %q: %q is not an index", s, b)
+			}
+			idx = append(idx, n)
+		}
+		if len(idx) == 2 && idx[0] >= idx[1] {
+			return errors.Errorf("invalid IndexRange %q: lower bound should be less than upper bound in %q", s, dim)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,39 @@
+// Copyright 2018 gopcua authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package datatypes
+
+import "testing"
+
+func TestValidateIndexRange(t *testing.T) {
+	var cases = []struct {
+		description string
+		indexRange  string
+		valid       bool
+	}{
+		{"empty", "", true},
+		{"single", "1", true},
+		{"range", "0:9", true},
+		{"multidimensional", "0:9,0:3", true},
+		{"mixed", "2,0:3", true},
+		{"reversed", "9:0", false},
+		{"same-bounds", "3:3", false},
+		{"too-many-bounds", "0:3:5", false},
+		{"negative", "-1", false},
+		{"not-a-number", "a:b", false},
+		{"empty-dimension", "0:3,", false},
+		{"space", "0: 3", false},
+	}
+	for _, c := range cases {
+		t.Run(c.description, func(t *testing.T) {
+			err := ValidateIndexRange(c.indexRange)
+			if c.valid && err != nil {
+				t.Errorf("expected valid, got %s", err)
+			}
+			if !c.valid && err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
@@ -575,3 +575,94 @@ func (o *OpaqueNodeID) GetIdentifier() []byte {
 func (o *OpaqueNodeID) String() string {
 	return fmt.Sprintf("%x, %d, %d, %d", o.EncodingMask, o.Namespace, o.Length, o.Identifier)
 }
+
+// NodeIDArray represents an array of NodeIDs.
+// It does not correspond to a certain type from the specification
+// but makes encoding and decoding easier.
+type NodeIDArray struct {
+	ArraySize int32
+	NodeIDs   []NodeID
+}
+
+// NewNodeIDArray creates a new NodeIDArray from multiple NodeIDs.
+func NewNodeIDArray(ids []NodeID) *NodeIDArray {
+	if ids == nil {
+		return &NodeIDArray{
+			ArraySize: 0,
+		}
+	}
+
+	return &NodeIDArray{
+		ArraySize: int32(len(ids)),
+		NodeIDs:   ids,
+	}
+}
+
+// DecodeNodeIDArray decodes given bytes into NodeIDArray.
+func DecodeNodeIDArray(b []byte) (*NodeIDArray, error) {
+	n := &NodeIDArray{}
+	if err := n.DecodeFromBytes(b); err != nil {
+		return nil, err
+	}
+
+	return n, nil
+}
+
+// DecodeFromBytes decodes given bytes into NodeIDArray.
+func (n *NodeIDArray) DecodeFromBytes(b []byte) error {
+	if len(b) < 4 {
+		return errors.NewErrTooShortToDecode(n, "should be longer than 4 bytes")
+	}
+
+	n.ArraySize = int32(binary.LittleEndian.Uint32(b[:4]))
+	if n.ArraySize <= 0 {
+		return nil
+	}
+
+	offset := 4
+	for i := 1; i <= int(n.ArraySize); i++ {
+		id, err := DecodeNodeID(b[offset:])
+		if err != nil {
+			return err
+		}
+		n.NodeIDs = append(n.NodeIDs, id)
+		offset += id.Len()
+	}
+
+	return nil
+}
+
+// Serialize serializes NodeIDArray into bytes.
+func (n *NodeIDArray) Serialize() ([]byte, error) {
+	b := make([]byte, n.Len())
+	if err := n.SerializeTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// SerializeTo serializes NodeIDArray into bytes.
+func (n *NodeIDArray) SerializeTo(b []byte) error {
+	offset := 4
+	binary.LittleEndian.PutUint32(b[:4], uint32(n.ArraySize))
+
+	for _, id := range n.NodeIDs {
+		if err := id.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += id.Len()
+	}
+
+	return nil
+}
+
+// Len returns the actual length of NodeIDArray in int.
+func (n *NodeIDArray) Len() int {
+	l := 4
+	for _, id := range n.NodeIDs {
+		l += id.Len()
+	}
+
+	return l
+}
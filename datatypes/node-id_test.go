@@ -7,6 +7,8 @@ package datatypes
 import (
 	"encoding/hex"
 	"testing"
+
+	"github.com/google/go-cmp/cmp"
 )
 
 var testNodeIDBytes = [][]byte{
@@ -297,3 +299,66 @@ func TestSerializeNodeID(t *testing.T) {
 		t.Logf("%x", serialized)
 	})
 }
+
+var nodeIDArrayTests = []struct {
+	description string
+	bytes       []byte
+	na          *NodeIDArray
+}{
+	{
+		description: "empty",
+		bytes:       []byte{0x00, 0x00, 0x00, 0x00},
+		na:          NewNodeIDArray(nil),
+	},
+	{
+		description: "two-byte, four-byte and string",
+		bytes: []byte{
+			0x03, 0x00, 0x00, 0x00, 0x00, 0xff, 0x01, 0x00,
+			0xfe, 0xca, 0x03, 0xff, 0x00, 0x06, 0x00, 0x00,
+			0x00, 0x66, 0x6f, 0x6f, 0x62, 0x61, 0x72,
+		},
+		na: NewNodeIDArray([]NodeID{
+			NewTwoByteNodeID(0xff),
+			NewFourByteNodeID(0, 0xcafe),
+			NewStringNodeID(0xff, "foobar"),
+		}),
+	},
+}
+
+func TestDecodeNodeIDArray(t *testing.T) {
+	for _, test := range nodeIDArrayTests {
+		t.Run(test.description, func(t *testing.T) {
+			n, err := DecodeNodeIDArray(test.bytes)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(n, test.na); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestNodeIDArraySerialize(t *testing.T) {
+	for _, test := range nodeIDArrayTests {
+		t.Run(test.description, func(t *testing.T) {
+			b, err := test.na.Serialize()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(b, test.bytes); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestNodeIDArrayLen(t *testing.T) {
+	for _, test := range nodeIDArrayTests {
+		t.Run(test.description, func(t *testing.T) {
+			if test.na.Len() != len(test.bytes) {
+				t.Errorf("Len doesn't match. Want: %d, Got: %d", len(test.bytes), test.na.Len())
+			}
+		})
+	}
+}
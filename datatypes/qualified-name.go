@@ -1,6 +1,10 @@
 package datatypes
 
-import "encoding/binary"
+import (
+	"encoding/binary"
+
+	"github.com/wmnsk/gopcua/errors"
+)
 
 // QualifiedName contains a qualified name. It is, for example, used as BrowseName.
 // The name part of the QualifiedName is restricted to 512 characters.
@@ -71,3 +75,94 @@ func (q *QualifiedName) SerializeTo(b []byte) error {
 func (q *QualifiedName) Len() int {
 	return 2 + q.Name.Len()
 }
+
+// QualifiedNameArray represents an array of QualifiedNames.
+// It does not correspond to a certain type from the specification
+// but makes encoding and decoding easier.
+type QualifiedNameArray struct {
+	ArraySize      int32
+	QualifiedNames []*QualifiedName
+}
+
+// NewQualifiedNameArray creates a new QualifiedNameArray from multiple QualifiedNames.
+func NewQualifiedNameArray(qualifiedNames []*QualifiedName) *QualifiedNameArray {
+	if qualifiedNames == nil {
+		return &QualifiedNameArray{
+			ArraySize: 0,
+		}
+	}
+
+	return &QualifiedNameArray{
+		ArraySize:      int32(len(qualifiedNames)),
+		QualifiedNames: qualifiedNames,
+	}
+}
+
+// DecodeQualifiedNameArray decodes given bytes into QualifiedNameArray.
+func DecodeQualifiedNameArray(b []byte) (*QualifiedNameArray, error) {
+	q := &QualifiedNameArray{}
+	if err := q.DecodeFromBytes(b); err != nil {
+		return nil, err
+	}
+
+	return q, nil
+}
+
+// DecodeFromBytes decodes given bytes into QualifiedNameArray.
+func (q *QualifiedNameArray) DecodeFromBytes(b []byte) error {
+	if len(b) < 4 {
+		return errors.NewErrTooShortToDecode(q, "should be longer than 4 bytes")
+	}
+
+	q.ArraySize = int32(binary.LittleEndian.Uint32(b[:4]))
+	if q.ArraySize <= 0 {
+		return nil
+	}
+
+	offset := 4
+	for i := 1; i <= int(q.ArraySize); i++ {
+		qualifiedName, err := DecodeQualifiedName(b[offset:])
+		if err != nil {
+			return err
+		}
+		q.QualifiedNames = append(q.QualifiedNames, qualifiedName)
+		offset += qualifiedName.Len()
+	}
+
+	return nil
+}
+
+// Serialize serializes QualifiedNameArray into bytes.
+func (q *QualifiedNameArray) Serialize() ([]byte, error) {
+	b := make([]byte, q.Len())
+	if err := q.SerializeTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// SerializeTo serializes QualifiedNameArray into bytes.
+func (q *QualifiedNameArray) SerializeTo(b []byte) error {
+	offset := 4
+	binary.LittleEndian.PutUint32(b[:4], uint32(q.ArraySize))
+
+	for _, qualifiedName := range q.QualifiedNames {
+		if err := qualifiedName.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += qualifiedName.Len()
+	}
+
+	return nil
+}
+
+// Len returns the actual length of QualifiedNameArray in int.
+func (q *QualifiedNameArray) Len() int {
+	l := 4
+	for _, qualifiedName := range q.QualifiedNames {
+		l += qualifiedName.Len()
+	}
+
+	return l
+}
@@ -116,3 +116,66 @@ func TestQualifiedNameLen(t *testing.T) {
 		t.Errorf("Len doesn't match. Want: %d, Got: %d", 12, q.Len())
 	}
 }
+
+var qualifiedNameArrayTests = []struct {
+	description string
+	bytes       []byte
+	qa          *QualifiedNameArray
+}{
+	{
+		description: "empty",
+		bytes:       []byte{0x00, 0x00, 0x00, 0x00},
+		qa:          NewQualifiedNameArray(nil),
+	},
+	{
+		description: "two names",
+		bytes: []byte{
+			0x02, 0x00, 0x00, 0x00, 0x01, 0x00, 0x06, 0x00,
+			0x00, 0x00, 0x66, 0x6f, 0x6f, 0x62, 0x61, 0x72,
+			0x00, 0x00, 0x03, 0x00, 0x00, 0x00, 0x62, 0x61,
+			0x7a,
+		},
+		qa: NewQualifiedNameArray([]*QualifiedName{
+			NewQualifiedName(1, "foobar"),
+			NewQualifiedName(0, "baz"),
+		}),
+	},
+}
+
+func TestDecodeQualifiedNameArray(t *testing.T) {
+	for _, test := range qualifiedNameArrayTests {
+		t.Run(test.description, func(t *testing.T) {
+			q, err := DecodeQualifiedNameArray(test.bytes)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(q, test.qa); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestQualifiedNameArraySerialize(t *testing.T) {
+	for _, test := range qualifiedNameArrayTests {
+		t.Run(test.description, func(t *testing.T) {
+			b, err := test.qa.Serialize()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(b, test.bytes); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestQualifiedNameArrayLen(t *testing.T) {
+	for _, test := range qualifiedNameArrayTests {
+		t.Run(test.description, func(t *testing.T) {
+			if test.qa.Len() != len(test.bytes) {
+				t.Errorf("Len doesn't match. Want: %d, Got: %d", len(test.bytes), test.qa.Len())
+			}
+		})
+	}
+}
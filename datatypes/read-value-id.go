@@ -125,6 +125,9 @@ func (r *ReadValueID) SerializeTo(b []byte) error {
 
 	// index range
 	if r.IndexRange != nil {
+		if err := ValidateIndexRange(r.IndexRange.Get()); err != nil {
+			return err
+		}
 		if err := r.IndexRange.SerializeTo(b[offset:]); err != nil {
 			return err
 		}
@@ -89,6 +89,13 @@ func TestReadValueIDSerialize(t *testing.T) {
 	}
 }
 
+func TestReadValueIDSerializeInvalidIndexRange(t *testing.T) {
+	r := NewReadValueID(NewFourByteNodeID(0, 2256), IntegerIDValue, "0:3:5", 0, "")
+	if _, err := r.Serialize(); err == nil {
+		t.Error("expected error")
+	}
+}
+
 func TestReadValueIDSerializeTo(t *testing.T) {
 	expected := []byte{
 		0x01, 0x00, 0xd0, 0x08, 0x0d, 0x00, 0x00, 0x00,
@@ -5,6 +5,8 @@
 package datatypes
 
 import (
+	"encoding/binary"
+
 	"github.com/wmnsk/gopcua/errors"
 	"github.com/wmnsk/gopcua/id"
 )
@@ -40,22 +42,153 @@ func DecodeVariant(b []byte) (*Variant, error) {
 
 // DecodeFromBytes decodes given bytes into Variant.
 func (v *Variant) DecodeFromBytes(b []byte) error {
+	if len(b) < 1 {
+		return errors.NewErrTooShortToDecode(v, "should be longer than 1 byte")
+	}
 	v.EncodingMask = b[0]
 
-	switch v.EncodingMask {
+	value, err := newVariantValue(v.EncodingMask)
+	if err != nil {
+		return err
+	}
+	v.Value = value
+
+	if err := v.Value.DecodeFromBytes(b[1:]); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Bool returns the value of Variant as bool.
+// The second value is false if Variant does not hold a Boolean.
+func (v *Variant) Bool() (bool, bool) {
+	b, ok := v.Value.(*Boolean)
+	if !ok || b == nil {
+		return false, false
+	}
+	return b.Value != 0, true
+}
+
+// Float returns the value of Variant as float32.
+// The second value is false if Variant does not hold a Float.
+func (v *Variant) Float() (float32, bool) {
+	f, ok := v.Value.(*Float)
+	if !ok || f == nil {
+		return 0, false
+	}
+	return f.Value, true
+}
+
+// Text returns the text of LocalizedText in Variant.
+// The second value is false if Variant does not hold a LocalizedText.
+func (v *Variant) Text() (string, bool) {
+	l, ok := v.Value.(*LocalizedText)
+	if !ok || l == nil {
+		return "", false
+	}
+	if l.Text == nil {
+		return "", true
+	}
+	return l.Text.Get(), true
+}
+
+// Interface returns the value of Variant in Go native type, which is
+// bool for Boolean, float32 for Float, and string for LocalizedText(Text only).
+// For ExtensionObject, it returns the body decoded into the type registered with
+// RegisterExtensionObject.
+//
+// It returns nil if Variant holds no value or the type is not supported.
+func (v *Variant) Interface() interface{} {
+	switch x := v.Value.(type) {
+	case *Boolean:
+		if x == nil {
+			return nil
+		}
+		return x.Value != 0
+	case *Float:
+		if x == nil {
+			return nil
+		}
+		return x.Value
+	case *LocalizedText:
+		if x == nil {
+			return nil
+		}
+		if x.Text == nil {
+			return ""
+		}
+		return x.Text.Get()
+	case *ExtensionObject:
+		if x == nil || x.Value == nil {
+			return nil
+		}
+		return x.Value
+	default:
+		return nil
+	}
+}
+
+// Bits in EncodingMask of Variant other than the type ID.
+const (
+	variantArrayValues     = 0x80
+	variantArrayDimensions = 0x40
+	variantTypeIDMask      = 0x3f
+)
+
+func newVariantValue(typeID uint8) (Data, error) {
+	switch typeID {
 	case id.Boolean:
-		v.Value = &Boolean{}
+		return &Boolean{}, nil
 	case id.LocalizedText:
-		v.Value = &LocalizedText{}
+		return &LocalizedText{}, nil
 	case id.Float:
-		v.Value = &Float{}
+		return &Float{}, nil
+	case id.Structure:
+		return &ExtensionObject{}, nil
 	default:
-		return errors.NewErrInvalidType(v.EncodingMask, "decode", "got undefined type")
+		return nil, errors.NewErrInvalidType(typeID, "decode", "got undefined type")
 	}
+}
 
-	if err := v.Value.DecodeFromBytes(b[1:]); err != nil {
-		return err
+// DecodeVariantArray decodes the elements of an array Variant one by one and
+// calls fn with the index and the value of each element.
+//
+// Unlike decoding the whole Variant, the decoded elements are not accumulated,
+// so the memory used does not grow with the length of the array. This comes at
+// the cost of a function call per element, and the caller needs to keep what
+// it needs from each value by itself. Decoding stops and the error is returned
+// as soon as fn returns non-nil.
+//
+// The ArrayDimensions field, if any, is not passed to fn.
+func DecodeVariantArray(b []byte, fn func(index int, value Data) error) error {
+	if len(b) < 5 {
+		return errors.NewErrTooShortToDecode(&Variant{}, "should be longer than 5 bytes")
 	}
+	if b[0]&variantArrayValues == 0 {
+		return errors.NewErrInvalidType(b[0], "decode", "not an array")
+	}
+	typeID := b[0] & variantTypeIDMask
+
+	n := int32(binary.LittleEndian.Uint32(b[1:5]))
+	offset := 5
+	for i := 0; i < int(n); i++ {
+		value, err := newVariantValue(typeID)
+		if err != nil {
+			return err
+		}
+		if len(b[offset:]) < value.Len() {
+			return errors.NewErrTooShortToDecode(value, "array is shorter than ArrayLength")
+		}
+		if err := value.DecodeFromBytes(b[offset:]); err != nil {
+			return err
+		}
+		offset += value.Len()
+
+		if err := fn(i, value); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
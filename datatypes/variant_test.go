@@ -5,6 +5,7 @@
 package datatypes
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -118,3 +119,98 @@ func TestVariantLen(t *testing.T) {
 		})
 	}
 }
+
+func TestDecodeVariantArray(t *testing.T) {
+	in := []byte{
+		// EncodingMask: Float with ArrayValues
+		0x8a,
+		// ArrayLength
+		0x03, 0x00, 0x00, 0x00,
+		// Values
+		0x00, 0x00, 0x80, 0x3f,
+		0x00, 0x00, 0x00, 0x40,
+		0x00, 0x00, 0x40, 0x40,
+	}
+	expected := []Data{NewFloat(1), NewFloat(2), NewFloat(3)}
+
+	t.Run("all", func(t *testing.T) {
+		var got []Data
+		err := DecodeVariantArray(in, func(i int, v Data) error {
+			if i != len(got) {
+				t.Errorf("index doesn't match. Want: %d, Got: %d", len(got), i)
+			}
+			got = append(got, v)
+			return nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, expected, opt); diff != "" {
+			t.Error(diff)
+		}
+	})
+	t.Run("stop", func(t *testing.T) {
+		stop := errors.New("stop")
+		n := 0
+		err := DecodeVariantArray(in, func(i int, v Data) error {
+			n++
+			if i == 1 {
+				return stop
+			}
+			return nil
+		})
+		if err != stop {
+			t.Errorf("error doesn't match. Want: %v, Got: %v", stop, err)
+		}
+		if n != 2 {
+			t.Errorf("callback count doesn't match. Want: 2, Got: %d", n)
+		}
+	})
+	t.Run("too-short", func(t *testing.T) {
+		if err := DecodeVariantArray(in[:10], func(int, Data) error { return nil }); err == nil {
+			t.Error("expected error")
+		}
+	})
+	t.Run("not-array", func(t *testing.T) {
+		if err := DecodeVariantArray([]byte{0x0a, 0x00, 0x00, 0x80, 0x3f}, func(int, Data) error { return nil }); err == nil {
+			t.Error("expected error")
+		}
+	})
+}
+
+func TestVariantAccessors(t *testing.T) {
+	var cases = []struct {
+		description string
+		variant     *Variant
+		expected    interface{}
+	}{
+		{"boolean", NewVariant(NewBoolean(true)), true},
+		{"float", NewVariant(NewFloat(4.5)), float32(4.5)},
+		{"localized text", NewVariant(NewLocalizedText("en", "Gross value")), "Gross value"},
+		{"empty", &Variant{}, nil},
+		{"nil boolean", &Variant{Value: (*Boolean)(nil)}, nil},
+		{"nil float", &Variant{Value: (*Float)(nil)}, nil},
+		{"nil localized text", &Variant{Value: (*LocalizedText)(nil)}, nil},
+		{"nil extension object", &Variant{Value: (*ExtensionObject)(nil)}, nil},
+	}
+	for _, c := range cases {
+		t.Run(c.description, func(t *testing.T) {
+			if diff := cmp.Diff(c.variant.Interface(), c.expected); diff != "" {
+				t.Error(diff)
+			}
+
+			b, ok := c.variant.Bool()
+			if want, isBool := c.expected.(bool); ok != isBool || b != want {
+				t.Errorf("Bool doesn't match. Want: %v, %v, Got: %v, %v", want, isBool, b, ok)
+			}
+			f, ok := c.variant.Float()
+			if want, isFloat := c.expected.(float32); ok != isFloat || f != want {
+				t.Errorf("Float doesn't match. Want: %v, %v, Got: %v, %v", want, isFloat, f, ok)
+			}
+			s, ok := c.variant.Text()
+			if want, isText := c.expected.(string); ok != isText || s != want {
+				t.Errorf("Text doesn't match. Want: %v, %v, Got: %v, %v", want, isText, s, ok)
+			}
+		})
+	}
+}
@@ -0,0 +1,315 @@
+// Copyright 2018 gopcua authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package services
+
+import (
+	"encoding/binary"
+
+	"github.com/wmnsk/gopcua/datatypes"
+	"github.com/wmnsk/gopcua/errors"
+	"github.com/wmnsk/gopcua/id"
+)
+
+// NodeClass is an enumeration that identifies the NodeClass of a Node.
+//
+// Specification: Part 3, 8.29
+type NodeClass uint32
+
+// NodeClass definitions.
+const (
+	NodeClassUnspecified   NodeClass = 0
+	NodeClassObject        NodeClass = 1
+	NodeClassVariable      NodeClass = 2
+	NodeClassMethod        NodeClass = 4
+	NodeClassObjectType    NodeClass = 8
+	NodeClassVariableType  NodeClass = 16
+	NodeClassReferenceType NodeClass = 32
+	NodeClassDataType      NodeClass = 64
+	NodeClassView          NodeClass = 128
+)
+
+// AddNodesItem represents a Node to be added in AddNodesRequest.
+//
+// Specification: Part 4, 5.7.2.2
+type AddNodesItem struct {
+	ParentNodeID    *datatypes.ExpandedNodeID
+	ReferenceTypeID datatypes.NodeID
+
+	// Client requested expanded NodeID of the Node to add.
+	// It should be null if the Server is expected to assign the NodeID.
+	RequestedNewNodeID *datatypes.ExpandedNodeID
+	BrowseName         *datatypes.QualifiedName
+	NodeClass          NodeClass
+
+	// The Attributes specific to the NodeClass, e.g. VariableAttributes.
+	NodeAttributes *datatypes.ExtensionObject
+	TypeDefinition *datatypes.ExpandedNodeID
+}
+
+// NewAddNodesItem creates a new AddNodesItem.
+func NewAddNodesItem(parentID *datatypes.ExpandedNodeID, refTypeID datatypes.NodeID, newID *datatypes.ExpandedNodeID, browseName *datatypes.QualifiedName, nodeClass NodeClass, attrs *datatypes.ExtensionObject, typeDef *datatypes.ExpandedNodeID) *AddNodesItem {
+	return &AddNodesItem{
+		ParentNodeID:       parentID,
+		ReferenceTypeID:    refTypeID,
+		RequestedNewNodeID: newID,
+		BrowseName:         browseName,
+		NodeClass:          nodeClass,
+		NodeAttributes:     attrs,
+		TypeDefinition:     typeDef,
+	}
+}
+
+// NewVariableNodesItem creates a new AddNodesItem to add a Variable Node of
+// BaseDataVariableType as the component of the parent Node.
+// The NodeID of the new Node is assigned by the Server.
+func NewVariableNodesItem(parentID datatypes.NodeID, browseName *datatypes.QualifiedName, attrs *VariableAttributes) *AddNodesItem {
+	return NewAddNodesItem(
+		datatypes.NewExpandedNodeID(false, false, parentID, "", 0),
+		datatypes.NewFourByteNodeID(0, id.HasComponent),
+		datatypes.NewExpandedNodeID(false, false, datatypes.NewTwoByteNodeID(0), "", 0),
+		browseName,
+		NodeClassVariable,
+		attrs.ExtensionObject(),
+		datatypes.NewExpandedNodeID(false, false, datatypes.NewFourByteNodeID(0, id.BaseDataVariableType), "", 0),
+	)
+}
+
+// VariableAttributes returns the NodeAttributes of AddNodesItem if it is VariableAttributes.
+func (a *AddNodesItem) VariableAttributes() (*VariableAttributes, bool) {
+	if a.NodeAttributes == nil {
+		return nil, false
+	}
+	v, ok := a.NodeAttributes.Value.(variableAttributesData)
+	if !ok {
+		return nil, false
+	}
+	return v.VariableAttributes, true
+}
+
+// DecodeAddNodesItem decodes given bytes into AddNodesItem.
+func DecodeAddNodesItem(b []byte) (*AddNodesItem, error) {
+	a := &AddNodesItem{}
+	if err := a.DecodeFromBytes(b); err != nil {
+		return nil, err
+	}
+
+	return a, nil
+}
+
+// DecodeFromBytes decodes given bytes into AddNodesItem.
+func (a *AddNodesItem) DecodeFromBytes(b []byte) error {
+	offset := 0
+	a.ParentNodeID = &datatypes.ExpandedNodeID{}
+	if err := a.ParentNodeID.DecodeFromBytes(b[offset:]); err != nil {
+		return err
+	}
+	offset += a.ParentNodeID.Len()
+
+	referenceTypeID, err := datatypes.DecodeNodeID(b[offset:])
+	if err != nil {
+		return err
+	}
+	a.ReferenceTypeID = referenceTypeID
+	offset += a.ReferenceTypeID.Len()
+
+	a.RequestedNewNodeID = &datatypes.ExpandedNodeID{}
+	if err := a.RequestedNewNodeID.DecodeFromBytes(b[offset:]); err != nil {
+		return err
+	}
+	offset += a.RequestedNewNodeID.Len()
+
+	a.BrowseName = &datatypes.QualifiedName{}
+	if err := a.BrowseName.DecodeFromBytes(b[offset:]); err != nil {
+		return err
+	}
+	offset += a.BrowseName.Len()
+
+	a.NodeClass = NodeClass(binary.LittleEndian.Uint32(b[offset : offset+4]))
+	offset += 4
+
+	a.NodeAttributes = &datatypes.ExtensionObject{}
+	if err := a.NodeAttributes.DecodeFromBytes(b[offset:]); err != nil {
+		return err
+	}
+	offset += a.NodeAttributes.Len()
+
+	a.TypeDefinition = &datatypes.ExpandedNodeID{}
+	return a.TypeDefinition.DecodeFromBytes(b[offset:])
+}
+
+// Serialize serializes AddNodesItem into bytes.
+func (a *AddNodesItem) Serialize() ([]byte, error) {
+	b := make([]byte, a.Len())
+	if err := a.SerializeTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// SerializeTo serializes AddNodesItem into bytes.
+func (a *AddNodesItem) SerializeTo(b []byte) error {
+	offset := 0
+	if a.ParentNodeID != nil {
+		if err := a.ParentNodeID.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += a.ParentNodeID.Len()
+	}
+
+	if a.ReferenceTypeID != nil {
+		if err := a.ReferenceTypeID.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += a.ReferenceTypeID.Len()
+	}
+
+	if a.RequestedNewNodeID != nil {
+		if err := a.RequestedNewNodeID.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += a.RequestedNewNodeID.Len()
+	}
+
+	if a.BrowseName != nil {
+		if err := a.BrowseName.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += a.BrowseName.Len()
+	}
+
+	binary.LittleEndian.PutUint32(b[offset:offset+4], uint32(a.NodeClass))
+	offset += 4
+
+	if a.NodeAttributes != nil {
+		if err := a.NodeAttributes.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += a.NodeAttributes.Len()
+	}
+
+	if a.TypeDefinition != nil {
+		return a.TypeDefinition.SerializeTo(b[offset:])
+	}
+
+	return nil
+}
+
+// Len returns the actual length of AddNodesItem in int.
+func (a *AddNodesItem) Len() int {
+	// NodeClass
+	l := 4
+	if a.ParentNodeID != nil {
+		l += a.ParentNodeID.Len()
+	}
+	if a.ReferenceTypeID != nil {
+		l += a.ReferenceTypeID.Len()
+	}
+	if a.RequestedNewNodeID != nil {
+		l += a.RequestedNewNodeID.Len()
+	}
+	if a.BrowseName != nil {
+		l += a.BrowseName.Len()
+	}
+	if a.NodeAttributes != nil {
+		l += a.NodeAttributes.Len()
+	}
+	if a.TypeDefinition != nil {
+		l += a.TypeDefinition.Len()
+	}
+
+	return l
+}
+
+// AddNodesItemArray represents an array of AddNodesItems.
+// It does not correspond to a certain type from the specification
+// but makes encoding and decoding easier.
+type AddNodesItemArray struct {
+	ArraySize  int32
+	NodesToAdd []*AddNodesItem
+}
+
+// NewAddNodesItemArray creates a new AddNodesItemArray from multiple AddNodesItems.
+func NewAddNodesItemArray(nodesToAdd []*AddNodesItem) *AddNodesItemArray {
+	if nodesToAdd == nil {
+		return &AddNodesItemArray{
+			ArraySize: 0,
+		}
+	}
+
+	return &AddNodesItemArray{
+		ArraySize:  int32(len(nodesToAdd)),
+		NodesToAdd: nodesToAdd,
+	}
+}
+
+// DecodeAddNodesItemArray decodes given bytes into AddNodesItemArray.
+func DecodeAddNodesItemArray(b []byte) (*AddNodesItemArray, error) {
+	a := &AddNodesItemArray{}
+	if err := a.DecodeFromBytes(b); err != nil {
+		return nil, err
+	}
+
+	return a, nil
+}
+
+// DecodeFromBytes decodes given bytes into AddNodesItemArray.
+func (a *AddNodesItemArray) DecodeFromBytes(b []byte) error {
+	if len(b) < 4 {
+		return errors.NewErrTooShortToDecode(a, "should be longer than 4 bytes")
+	}
+
+	a.ArraySize = int32(binary.LittleEndian.Uint32(b[:4]))
+	if a.ArraySize <= 0 {
+		return nil
+	}
+
+	offset := 4
+	for i := 1; i <= int(a.ArraySize); i++ {
+		addNodesItem, err := DecodeAddNodesItem(b[offset:])
+		if err != nil {
+			return err
+		}
+		a.NodesToAdd = append(a.NodesToAdd, addNodesItem)
+		offset += addNodesItem.Len()
+	}
+
+	return nil
+}
+
+// Serialize serializes AddNodesItemArray into bytes.
+func (a *AddNodesItemArray) Serialize() ([]byte, error) {
+	b := make([]byte, a.Len())
+	if err := a.SerializeTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// SerializeTo serializes AddNodesItemArray into bytes.
+func (a *AddNodesItemArray) SerializeTo(b []byte) error {
+	offset := 4
+	binary.LittleEndian.PutUint32(b[:4], uint32(a.ArraySize))
+
+	for _, addNodesItem := range a.NodesToAdd {
+		if err := addNodesItem.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += addNodesItem.Len()
+	}
+
+	return nil
+}
+
+// Len returns the actual length of AddNodesItemArray in int.
+func (a *AddNodesItemArray) Len() int {
+	l := 4
+	for _, addNodesItem := range a.NodesToAdd {
+		l += addNodesItem.Len()
+	}
+
+	return l
+}
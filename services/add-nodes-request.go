@@ -0,0 +1,135 @@
+// Copyright 2018 gopcua authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package services
+
+import (
+	"time"
+
+	"github.com/wmnsk/gopcua/datatypes"
+)
+
+// AddNodesRequest is used to add one or more Nodes into the AddressSpace hierarchy.
+//
+// Specification: Part 4, 5.7.2.2
+type AddNodesRequest struct {
+	TypeID *datatypes.ExpandedNodeID
+	*RequestHeader
+	NodesToAdd *AddNodesItemArray
+}
+
+// NewAddNodesRequest creates a new AddNodesRequest.
+func NewAddNodesRequest(ts time.Time, authToken datatypes.NodeID, handle, diag, timeout uint32, auditID string, items ...*AddNodesItem) *AddNodesRequest {
+	return &AddNodesRequest{
+		TypeID: datatypes.NewExpandedNodeID(
+			false, false,
+			datatypes.NewFourByteNodeID(
+				0, ServiceTypeAddNodesRequest,
+			),
+			"", 0,
+		),
+		RequestHeader: NewRequestHeader(
+			authToken,
+			ts,
+			handle,
+			diag,
+			timeout,
+			auditID,
+			NewAdditionalHeader(
+				datatypes.NewExpandedNodeID(
+					false, false,
+					datatypes.NewTwoByteNodeID(0),
+					"", 0,
+				),
+				0x00,
+			),
+			nil,
+		),
+		NodesToAdd: NewAddNodesItemArray(items),
+	}
+}
+
+// DecodeAddNodesRequest decodes given bytes into AddNodesRequest.
+func DecodeAddNodesRequest(b []byte) (*AddNodesRequest, error) {
+	a := &AddNodesRequest{}
+	if err := a.DecodeFromBytes(b); err != nil {
+		return nil, err
+	}
+
+	return a, nil
+}
+
+// DecodeFromBytes decodes given bytes into AddNodesRequest.
+func (a *AddNodesRequest) DecodeFromBytes(b []byte) error {
+	offset := 0
+	a.TypeID = &datatypes.ExpandedNodeID{}
+	if err := a.TypeID.DecodeFromBytes(b[offset:]); err != nil {
+		return err
+	}
+	offset += a.TypeID.Len()
+
+	a.RequestHeader = &RequestHeader{}
+	if err := a.RequestHeader.DecodeFromBytes(b[offset:]); err != nil {
+		return err
+	}
+	offset += a.RequestHeader.Len() - len(a.RequestHeader.Payload)
+
+	a.NodesToAdd = &AddNodesItemArray{}
+	return a.NodesToAdd.DecodeFromBytes(b[offset:])
+}
+
+// Serialize serializes AddNodesRequest into bytes.
+func (a *AddNodesRequest) Serialize() ([]byte, error) {
+	b := make([]byte, a.Len())
+	if err := a.SerializeTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// SerializeTo serializes AddNodesRequest into bytes.
+func (a *AddNodesRequest) SerializeTo(b []byte) error {
+	offset := 0
+	if a.TypeID != nil {
+		if err := a.TypeID.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += a.TypeID.Len()
+	}
+
+	if a.RequestHeader != nil {
+		if err := a.RequestHeader.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += a.RequestHeader.Len() - len(a.Payload)
+	}
+
+	if a.NodesToAdd != nil {
+		return a.NodesToAdd.SerializeTo(b[offset:])
+	}
+
+	return nil
+}
+
+// Len returns the actual length of AddNodesRequest in int.
+func (a *AddNodesRequest) Len() int {
+	l := 0
+	if a.TypeID != nil {
+		l += a.TypeID.Len()
+	}
+	if a.RequestHeader != nil {
+		l += (a.RequestHeader.Len() - len(a.Payload))
+	}
+	if a.NodesToAdd != nil {
+		l += a.NodesToAdd.Len()
+	}
+
+	return l
+}
+
+// ServiceType returns type of Service in uint16.
+func (a *AddNodesRequest) ServiceType() uint16 {
+	return ServiceTypeAddNodesRequest
+}
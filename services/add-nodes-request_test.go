@@ -0,0 +1,178 @@
+// Copyright 2018 gopcua authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/wmnsk/gopcua/datatypes"
+	"github.com/wmnsk/gopcua/id"
+)
+
+var addNodesRequestCases = []struct {
+	description string
+	structured  *AddNodesRequest
+	serialized  []byte
+}{
+	{
+		"normal",
+		NewAddNodesRequest(
+			time.Date(2018, time.August, 10, 23, 0, 0, 0, time.UTC),
+			datatypes.NewTwoByteNodeID(0), 1, 0, 0, "",
+			NewVariableNodesItem(
+				datatypes.NewFourByteNodeID(0, id.ObjectsFolder),
+				datatypes.NewQualifiedName(1, "foo"),
+				NewScalarVariableAttributes(
+					"foo",
+					datatypes.NewTwoByteNodeID(id.Float),
+					datatypes.NewVariant(datatypes.NewFloat(2.50025)),
+				),
+			),
+		),
+		[]byte{ // AddNodesRequest
+			// TypeID
+			0x01, 0x00, 0xe8, 0x01,
+			// RequestHeader
+			// AuthenticationToken
+			0x00, 0x00,
+			// Timestamp
+			0x00, 0x98, 0x67, 0xdd, 0xfd, 0x30, 0xd4, 0x01,
+			// RequestHandle
+			0x01, 0x00, 0x00, 0x00,
+			// ReturnDiagnostics
+			0x00, 0x00, 0x00, 0x00,
+			// AuditEntryID
+			0xff, 0xff, 0xff, 0xff,
+			// TimeoutHint
+			0x00, 0x00, 0x00, 0x00,
+			// AdditionalHeader
+			0x00, 0x00, 0x00,
+			// NodesToAdd
+			// ArraySize
+			0x01, 0x00, 0x00, 0x00,
+			// ParentNodeID
+			0x01, 0x00, 0x55, 0x00,
+			// ReferenceTypeID
+			0x01, 0x00, 0x2f, 0x00,
+			// RequestedNewNodeID
+			0x00, 0x00,
+			// BrowseName
+			0x01, 0x00, 0x03, 0x00, 0x00, 0x00, 0x66, 0x6f,
+			0x6f,
+			// NodeClass
+			0x02, 0x00, 0x00, 0x00,
+			// NodeAttributes
+			// TypeID
+			0x01, 0x00, 0x65, 0x01,
+			// EncodingMask
+			0x01,
+			// Length
+			0x2f, 0x00, 0x00, 0x00,
+			// SpecifiedAttributes
+			0x51, 0x00, 0x29, 0x00,
+			// DisplayName
+			0x02, 0x03, 0x00, 0x00, 0x00, 0x66, 0x6f, 0x6f,
+			// Description
+			0x00,
+			// WriteMask
+			0x00, 0x00, 0x00, 0x00,
+			// UserWriteMask
+			0x00, 0x00, 0x00, 0x00,
+			// Value
+			0x0a, 0x19, 0x04, 0x20, 0x40,
+			// DataType
+			0x00, 0x0a,
+			// ValueRank
+			0xff, 0xff, 0xff, 0xff,
+			// ArrayDimensions
+			0x00, 0x00, 0x00, 0x00,
+			// AccessLevel
+			0x03,
+			// UserAccessLevel
+			0x03,
+			// MinimumSamplingInterval
+			0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			// Historizing
+			0x00,
+			// TypeDefinition
+			0x01, 0x00, 0x3f, 0x00,
+		},
+	},
+}
+
+func TestDecodeAddNodesRequest(t *testing.T) {
+	for _, c := range addNodesRequestCases {
+		got, err := DecodeAddNodesRequest(c.serialized)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// need to clear Payload here.
+		got.Payload = nil
+
+		if diff := cmp.Diff(got, c.structured, decodeCmpOpt); diff != "" {
+			t.Errorf("%s failed\n%s", c.description, diff)
+		}
+	}
+}
+
+func TestSerializeAddNodesRequest(t *testing.T) {
+	for _, c := range addNodesRequestCases {
+		got, err := c.structured.Serialize()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if diff := cmp.Diff(got, c.serialized); diff != "" {
+			t.Errorf("%s failed\n%s", c.description, diff)
+		}
+	}
+}
+
+func TestAddNodesRequestLen(t *testing.T) {
+	for _, c := range addNodesRequestCases {
+		got := c.structured.Len()
+
+		if diff := cmp.Diff(got, len(c.serialized)); diff != "" {
+			t.Errorf("%s failed\n%s", c.description, diff)
+		}
+	}
+}
+
+func TestAddNodesRequestServiceType(t *testing.T) {
+	for _, c := range addNodesRequestCases {
+		if c.structured.ServiceType() != ServiceTypeAddNodesRequest {
+			t.Errorf(
+				"ServiceType doesn't match. Want: %d, Got: %d",
+				ServiceTypeAddNodesRequest,
+				c.structured.ServiceType(),
+			)
+		}
+	}
+}
+
+func TestAddNodesItemVariableAttributes(t *testing.T) {
+	req, err := DecodeAddNodesRequest(addNodesRequestCases[0].serialized)
+	if err != nil {
+		t.Fatal(err)
+	}
+	attrs, ok := req.NodesToAdd.NodesToAdd[0].VariableAttributes()
+	if !ok {
+		t.Fatal("NodeAttributes should be VariableAttributes")
+	}
+	if got := attrs.DisplayName.Text.Get(); got != "foo" {
+		t.Errorf("DisplayName doesn't match. Want: foo, Got: %s", got)
+	}
+	if got, ok := attrs.Value.Float(); !ok || got != 2.50025 {
+		t.Errorf("Value doesn't match. Want: 2.50025, Got: %v", got)
+	}
+
+	if _, ok := NewAddNodesItem(nil, nil, nil, nil, NodeClassObject, nil, nil).VariableAttributes(); ok {
+		t.Error("NodeAttributes should not be VariableAttributes")
+	}
+}
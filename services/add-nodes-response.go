@@ -0,0 +1,154 @@
+// Copyright 2018 gopcua authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package services
+
+import (
+	"time"
+
+	"github.com/wmnsk/gopcua/datatypes"
+)
+
+// AddNodesResponse represents the response to an AddNodesRequest.
+// Results are in the same order as the items in the request, so that the Nodes
+// rejected by the Server can be identified with the StatusCode.
+//
+// Specification: Part 4, 5.7.2.2
+type AddNodesResponse struct {
+	TypeID *datatypes.ExpandedNodeID
+	*ResponseHeader
+	Results         *AddNodesResultArray
+	DiagnosticInfos *DiagnosticInfoArray
+}
+
+// NewAddNodesResponse creates a new AddNodesResponse.
+func NewAddNodesResponse(ts time.Time, handle, code uint32, diag *DiagnosticInfo, strs []string, results []*AddNodesResult, diags []*DiagnosticInfo) *AddNodesResponse {
+	return &AddNodesResponse{
+		TypeID: datatypes.NewExpandedNodeID(
+			false, false,
+			datatypes.NewFourByteNodeID(
+				0, ServiceTypeAddNodesResponse,
+			),
+			"", 0,
+		),
+		ResponseHeader: NewResponseHeader(
+			ts,
+			handle,
+			code,
+			diag,
+			strs,
+			NewAdditionalHeader(
+				datatypes.NewExpandedNodeID(
+					false, false,
+					datatypes.NewTwoByteNodeID(0),
+					"", 0,
+				),
+				0x00,
+			),
+			nil,
+		),
+		Results:         NewAddNodesResultArray(results),
+		DiagnosticInfos: NewDiagnosticInfoArray(diags),
+	}
+}
+
+// DecodeAddNodesResponse decodes given bytes into AddNodesResponse.
+func DecodeAddNodesResponse(b []byte) (*AddNodesResponse, error) {
+	a := &AddNodesResponse{}
+	if err := a.DecodeFromBytes(b); err != nil {
+		return nil, err
+	}
+
+	return a, nil
+}
+
+// DecodeFromBytes decodes given bytes into AddNodesResponse.
+func (a *AddNodesResponse) DecodeFromBytes(b []byte) error {
+	offset := 0
+	a.TypeID = &datatypes.ExpandedNodeID{}
+	if err := a.TypeID.DecodeFromBytes(b[offset:]); err != nil {
+		return err
+	}
+	offset += a.TypeID.Len()
+
+	a.ResponseHeader = &ResponseHeader{}
+	if err := a.ResponseHeader.DecodeFromBytes(b[offset:]); err != nil {
+		return err
+	}
+	offset += a.ResponseHeader.Len() - len(a.ResponseHeader.Payload)
+
+	a.Results = &AddNodesResultArray{}
+	if err := a.Results.DecodeFromBytes(b[offset:]); err != nil {
+		return err
+	}
+	offset += a.Results.Len()
+
+	a.DiagnosticInfos = &DiagnosticInfoArray{}
+	return a.DiagnosticInfos.DecodeFromBytes(b[offset:])
+}
+
+// Serialize serializes AddNodesResponse into bytes.
+func (a *AddNodesResponse) Serialize() ([]byte, error) {
+	b := make([]byte, a.Len())
+	if err := a.SerializeTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// SerializeTo serializes AddNodesResponse into bytes.
+func (a *AddNodesResponse) SerializeTo(b []byte) error {
+	offset := 0
+	if a.TypeID != nil {
+		if err := a.TypeID.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += a.TypeID.Len()
+	}
+
+	if a.ResponseHeader != nil {
+		if err := a.ResponseHeader.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += a.ResponseHeader.Len() - len(a.Payload)
+	}
+
+	if a.Results != nil {
+		if err := a.Results.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += a.Results.Len()
+	}
+
+	if a.DiagnosticInfos != nil {
+		return a.DiagnosticInfos.SerializeTo(b[offset:])
+	}
+
+	return nil
+}
+
+// Len returns the actual length of AddNodesResponse in int.
+func (a *AddNodesResponse) Len() int {
+	l := 0
+	if a.TypeID != nil {
+		l += a.TypeID.Len()
+	}
+	if a.ResponseHeader != nil {
+		l += (a.ResponseHeader.Len() - len(a.Payload))
+	}
+	if a.Results != nil {
+		l += a.Results.Len()
+	}
+	if a.DiagnosticInfos != nil {
+		l += a.DiagnosticInfos.Len()
+	}
+
+	return l
+}
+
+// ServiceType returns type of Service in uint16.
+func (a *AddNodesResponse) ServiceType() uint16 {
+	return ServiceTypeAddNodesResponse
+}
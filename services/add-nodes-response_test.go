@@ -0,0 +1,114 @@
+// Copyright 2018 gopcua authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/wmnsk/gopcua/datatypes"
+)
+
+var addNodesResponseCases = []struct {
+	description string
+	structured  *AddNodesResponse
+	serialized  []byte
+}{
+	{
+		"normal",
+		NewAddNodesResponse(
+			time.Date(2018, time.August, 10, 23, 0, 0, 0, time.UTC),
+			1, 0, nil, nil,
+			[]*AddNodesResult{
+				NewAddNodesResult(0, datatypes.NewFourByteNodeID(1, 1000)),
+				NewAddNodesResult(0x805e0000, datatypes.NewTwoByteNodeID(0)),
+			},
+			nil,
+		),
+		[]byte{ // AddNodesResponse
+			// TypeID
+			0x01, 0x00, 0xeb, 0x01,
+			// ResponseHeader
+			// Timestamp
+			0x00, 0x98, 0x67, 0xdd, 0xfd, 0x30, 0xd4, 0x01,
+			// RequestHandle
+			0x01, 0x00, 0x00, 0x00,
+			// ServiceResult
+			0x00, 0x00, 0x00, 0x00,
+			// ServiceDiagnostics
+			0x00,
+			// StringTable
+			0x00, 0x00, 0x00, 0x00,
+			// AdditionalHeader
+			0x00, 0x00, 0x00,
+			// Results
+			// ArraySize
+			0x02, 0x00, 0x00, 0x00,
+			// StatusCode
+			0x00, 0x00, 0x00, 0x00,
+			// AddedNodeID
+			0x01, 0x01, 0xe8, 0x03,
+			// StatusCode: BadNodeIdExists
+			0x00, 0x00, 0x5e, 0x80,
+			// AddedNodeID
+			0x00, 0x00,
+			// DiagnosticInfos
+			0x00, 0x00, 0x00, 0x00,
+		},
+	},
+}
+
+func TestDecodeAddNodesResponse(t *testing.T) {
+	for _, c := range addNodesResponseCases {
+		got, err := DecodeAddNodesResponse(c.serialized)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// need to clear Payload here.
+		got.Payload = nil
+
+		if diff := cmp.Diff(got, c.structured, decodeCmpOpt); diff != "" {
+			t.Errorf("%s failed\n%s", c.description, diff)
+		}
+	}
+}
+
+func TestSerializeAddNodesResponse(t *testing.T) {
+	for _, c := range addNodesResponseCases {
+		got, err := c.structured.Serialize()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if diff := cmp.Diff(got, c.serialized); diff != "" {
+			t.Errorf("%s failed\n%s", c.description, diff)
+		}
+	}
+}
+
+func TestAddNodesResponseLen(t *testing.T) {
+	for _, c := range addNodesResponseCases {
+		got := c.structured.Len()
+
+		if diff := cmp.Diff(got, len(c.serialized)); diff != "" {
+			t.Errorf("%s failed\n%s", c.description, diff)
+		}
+	}
+}
+
+func TestAddNodesResponseServiceType(t *testing.T) {
+	for _, c := range addNodesResponseCases {
+		if c.structured.ServiceType() != ServiceTypeAddNodesResponse {
+			t.Errorf(
+				"ServiceType doesn't match. Want: %d, Got: %d",
+				ServiceTypeAddNodesResponse,
+				c.structured.ServiceType(),
+			)
+		}
+	}
+}
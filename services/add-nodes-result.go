@@ -0,0 +1,179 @@
+// Copyright 2018 gopcua authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package services
+
+import (
+	"encoding/binary"
+
+	"github.com/wmnsk/gopcua/datatypes"
+	"github.com/wmnsk/gopcua/errors"
+)
+
+// AddNodesResult represents the result of adding a Node in AddNodesRequest.
+//
+// Specification: Part 4, 5.7.2.2
+type AddNodesResult struct {
+	// StatusCode for the Node to add, e.g. BadParentNodeIdInvalid.
+	StatusCode  uint32
+	AddedNodeID datatypes.NodeID
+}
+
+// NewAddNodesResult creates a new AddNodesResult.
+func NewAddNodesResult(code uint32, nodeID datatypes.NodeID) *AddNodesResult {
+	return &AddNodesResult{
+		StatusCode:  code,
+		AddedNodeID: nodeID,
+	}
+}
+
+// DecodeAddNodesResult decodes given bytes into AddNodesResult.
+func DecodeAddNodesResult(b []byte) (*AddNodesResult, error) {
+	a := &AddNodesResult{}
+	if err := a.DecodeFromBytes(b); err != nil {
+		return nil, err
+	}
+
+	return a, nil
+}
+
+// DecodeFromBytes decodes given bytes into AddNodesResult.
+func (a *AddNodesResult) DecodeFromBytes(b []byte) error {
+	offset := 0
+	a.StatusCode = binary.LittleEndian.Uint32(b[offset : offset+4])
+	offset += 4
+
+	addedNodeID, err := datatypes.DecodeNodeID(b[offset:])
+	if err != nil {
+		return err
+	}
+	a.AddedNodeID = addedNodeID
+
+	return nil
+}
+
+// Serialize serializes AddNodesResult into bytes.
+func (a *AddNodesResult) Serialize() ([]byte, error) {
+	b := make([]byte, a.Len())
+	if err := a.SerializeTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// SerializeTo serializes AddNodesResult into bytes.
+func (a *AddNodesResult) SerializeTo(b []byte) error {
+	offset := 0
+	binary.LittleEndian.PutUint32(b[offset:offset+4], a.StatusCode)
+	offset += 4
+
+	if a.AddedNodeID != nil {
+		return a.AddedNodeID.SerializeTo(b[offset:])
+	}
+
+	return nil
+}
+
+// Len returns the actual length of AddNodesResult in int.
+func (a *AddNodesResult) Len() int {
+	// StatusCode
+	l := 4
+	if a.AddedNodeID != nil {
+		l += a.AddedNodeID.Len()
+	}
+
+	return l
+}
+
+// AddNodesResultArray represents an array of AddNodesResults.
+// It does not correspond to a certain type from the specification
+// but makes encoding and decoding easier.
+type AddNodesResultArray struct {
+	ArraySize       int32
+	AddNodesResults []*AddNodesResult
+}
+
+// NewAddNodesResultArray creates a new AddNodesResultArray from multiple AddNodesResults.
+func NewAddNodesResultArray(addNodesResults []*AddNodesResult) *AddNodesResultArray {
+	if addNodesResults == nil {
+		return &AddNodesResultArray{
+			ArraySize: 0,
+		}
+	}
+
+	return &AddNodesResultArray{
+		ArraySize:       int32(len(addNodesResults)),
+		AddNodesResults: addNodesResults,
+	}
+}
+
+// DecodeAddNodesResultArray decodes given bytes into AddNodesResultArray.
+func DecodeAddNodesResultArray(b []byte) (*AddNodesResultArray, error) {
+	a := &AddNodesResultArray{}
+	if err := a.DecodeFromBytes(b); err != nil {
+		return nil, err
+	}
+
+	return a, nil
+}
+
+// DecodeFromBytes decodes given bytes into AddNodesResultArray.
+func (a *AddNodesResultArray) DecodeFromBytes(b []byte) error {
+	if len(b) < 4 {
+		return errors.NewErrTooShortToDecode(a, "should be longer than 4 bytes")
+	}
+
+	a.ArraySize = int32(binary.LittleEndian.Uint32(b[:4]))
+	if a.ArraySize <= 0 {
+		return nil
+	}
+
+	offset := 4
+	for i := 1; i <= int(a.ArraySize); i++ {
+		addNodesResult, err := DecodeAddNodesResult(b[offset:])
+		if err != nil {
+			return err
+		}
+		a.AddNodesResults = append(a.AddNodesResults, addNodesResult)
+		offset += addNodesResult.Len()
+	}
+
+	return nil
+}
+
+// Serialize serializes AddNodesResultArray into bytes.
+func (a *AddNodesResultArray) Serialize() ([]byte, error) {
+	b := make([]byte, a.Len())
+	if err := a.SerializeTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// SerializeTo serializes AddNodesResultArray into bytes.
+func (a *AddNodesResultArray) SerializeTo(b []byte) error {
+	offset := 4
+	binary.LittleEndian.PutUint32(b[:4], uint32(a.ArraySize))
+
+	for _, addNodesResult := range a.AddNodesResults {
+		if err := addNodesResult.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += addNodesResult.Len()
+	}
+
+	return nil
+}
+
+// Len returns the actual length of AddNodesResultArray in int.
+func (a *AddNodesResultArray) Len() int {
+	l := 4
+	for _, addNodesResult := range a.AddNodesResults {
+		l += addNodesResult.Len()
+	}
+
+	return l
+}
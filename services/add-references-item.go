@@ -0,0 +1,256 @@
+// Copyright 2018 gopcua authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package services
+
+import (
+	"encoding/binary"
+
+	"github.com/wmnsk/gopcua/datatypes"
+	"github.com/wmnsk/gopcua/errors"
+)
+
+// AddReferencesItem represents a Reference to be added in AddReferencesRequest.
+//
+// Specification: Part 4, 5.7.3.2
+type AddReferencesItem struct {
+	SourceNodeID    datatypes.NodeID
+	ReferenceTypeID datatypes.NodeID
+
+	// If true, the Reference is added in forward direction from SourceNodeID.
+	IsForward *datatypes.Boolean
+
+	// URI of the remote Server if the target Node is in another Server, or null.
+	TargetServerURI *datatypes.String
+	TargetNodeID    *datatypes.ExpandedNodeID
+	TargetNodeClass NodeClass
+}
+
+// NewAddReferencesItem creates a new AddReferencesItem.
+func NewAddReferencesItem(srcID datatypes.NodeID, refTypeID datatypes.NodeID, isForward bool, serverURI string, targetID *datatypes.ExpandedNodeID, nodeClass NodeClass) *AddReferencesItem {
+	return &AddReferencesItem{
+		SourceNodeID:    srcID,
+		ReferenceTypeID: refTypeID,
+		IsForward:       datatypes.NewBoolean(isForward),
+		TargetServerURI: datatypes.NewString(serverURI),
+		TargetNodeID:    targetID,
+		TargetNodeClass: nodeClass,
+	}
+}
+
+// DecodeAddReferencesItem decodes given bytes into AddReferencesItem.
+func DecodeAddReferencesItem(b []byte) (*AddReferencesItem, error) {
+	a := &AddReferencesItem{}
+	if err := a.DecodeFromBytes(b); err != nil {
+		return nil, err
+	}
+
+	return a, nil
+}
+
+// DecodeFromBytes decodes given bytes into AddReferencesItem.
+func (a *AddReferencesItem) DecodeFromBytes(b []byte) error {
+	sourceNodeID, err := datatypes.DecodeNodeID(b)
+	if err != nil {
+		return err
+	}
+	a.SourceNodeID = sourceNodeID
+	offset := a.SourceNodeID.Len()
+
+	referenceTypeID, err := datatypes.DecodeNodeID(b[offset:])
+	if err != nil {
+		return err
+	}
+	a.ReferenceTypeID = referenceTypeID
+	offset += a.ReferenceTypeID.Len()
+
+	a.IsForward = &datatypes.Boolean{}
+	if err := a.IsForward.DecodeFromBytes(b[offset:]); err != nil {
+		return err
+	}
+	offset += a.IsForward.Len()
+
+	a.TargetServerURI = &datatypes.String{}
+	if err := a.TargetServerURI.DecodeFromBytes(b[offset:]); err != nil {
+		return err
+	}
+	offset += a.TargetServerURI.Len()
+
+	a.TargetNodeID = &datatypes.ExpandedNodeID{}
+	if err := a.TargetNodeID.DecodeFromBytes(b[offset:]); err != nil {
+		return err
+	}
+	offset += a.TargetNodeID.Len()
+
+	a.TargetNodeClass = NodeClass(binary.LittleEndian.Uint32(b[offset : offset+4]))
+
+	return nil
+}
+
+// Serialize serializes AddReferencesItem into bytes.
+func (a *AddReferencesItem) Serialize() ([]byte, error) {
+	b := make([]byte, a.Len())
+	if err := a.SerializeTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// SerializeTo serializes AddReferencesItem into bytes.
+func (a *AddReferencesItem) SerializeTo(b []byte) error {
+	offset := 0
+	if a.SourceNodeID != nil {
+		if err := a.SourceNodeID.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += a.SourceNodeID.Len()
+	}
+
+	if a.ReferenceTypeID != nil {
+		if err := a.ReferenceTypeID.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += a.ReferenceTypeID.Len()
+	}
+
+	if a.IsForward != nil {
+		if err := a.IsForward.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += a.IsForward.Len()
+	}
+
+	if a.TargetServerURI != nil {
+		if err := a.TargetServerURI.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += a.TargetServerURI.Len()
+	}
+
+	if a.TargetNodeID != nil {
+		if err := a.TargetNodeID.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += a.TargetNodeID.Len()
+	}
+
+	binary.LittleEndian.PutUint32(b[offset:offset+4], uint32(a.TargetNodeClass))
+
+	return nil
+}
+
+// Len returns the actual length of AddReferencesItem in int.
+func (a *AddReferencesItem) Len() int {
+	// TargetNodeClass
+	l := 4
+	if a.SourceNodeID != nil {
+		l += a.SourceNodeID.Len()
+	}
+	if a.ReferenceTypeID != nil {
+		l += a.ReferenceTypeID.Len()
+	}
+	if a.IsForward != nil {
+		l += a.IsForward.Len()
+	}
+	if a.TargetServerURI != nil {
+		l += a.TargetServerURI.Len()
+	}
+	if a.TargetNodeID != nil {
+		l += a.TargetNodeID.Len()
+	}
+
+	return l
+}
+
+// AddReferencesItemArray represents an array of AddReferencesItems.
+// It does not correspond to a certain type from the specification
+// but makes encoding and decoding easier.
+type AddReferencesItemArray struct {
+	ArraySize       int32
+	ReferencesToAdd []*AddReferencesItem
+}
+
+// NewAddReferencesItemArray creates a new AddReferencesItemArray from multiple AddReferencesItems.
+func NewAddReferencesItemArray(referencesToAdd []*AddReferencesItem) *AddReferencesItemArray {
+	if referencesToAdd == nil {
+		return &AddReferencesItemArray{
+			ArraySize: 0,
+		}
+	}
+
+	return &AddReferencesItemArray{
+		ArraySize:       int32(len(referencesToAdd)),
+		ReferencesToAdd: referencesToAdd,
+	}
+}
+
+// DecodeAddReferencesItemArray decodes given bytes into AddReferencesItemArray.
+func DecodeAddReferencesItemArray(b []byte) (*AddReferencesItemArray, error) {
+	a := &AddReferencesItemArray{}
+	if err := a.DecodeFromBytes(b); err != nil {
+		return nil, err
+	}
+
+	return a, nil
+}
+
+// DecodeFromBytes decodes given bytes into AddReferencesItemArray.
+func (a *AddReferencesItemArray) DecodeFromBytes(b []byte) error {
+	if len(b) < 4 {
+		return errors.NewErrTooShortToDecode(a, "should be longer than 4 bytes")
+	}
+
+	a.ArraySize = int32(binary.LittleEndian.Uint32(b[:4]))
+	if a.ArraySize <= 0 {
+		return nil
+	}
+
+	offset := 4
+	for i := 1; i <= int(a.ArraySize); i++ {
+		addReferencesItem, err := DecodeAddReferencesItem(b[offset:])
+		if err != nil {
+			return err
+		}
+		a.ReferencesToAdd = append(a.ReferencesToAdd, addReferencesItem)
+		offset += addReferencesItem.Len()
+	}
+
+	return nil
+}
+
+// Serialize serializes AddReferencesItemArray into bytes.
+func (a *AddReferencesItemArray) Serialize() ([]byte, error) {
+	b := make([]byte, a.Len())
+	if err := a.SerializeTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// SerializeTo serializes AddReferencesItemArray into bytes.
+func (a *AddReferencesItemArray) SerializeTo(b []byte) error {
+	offset := 4
+	binary.LittleEndian.PutUint32(b[:4], uint32(a.ArraySize))
+
+	for _, addReferencesItem := range a.ReferencesToAdd {
+		if err := addReferencesItem.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += addReferencesItem.Len()
+	}
+
+	return nil
+}
+
+// Len returns the actual length of AddReferencesItemArray in int.
+func (a *AddReferencesItemArray) Len() int {
+	l := 4
+	for _, addReferencesItem := range a.ReferencesToAdd {
+		l += addReferencesItem.Len()
+	}
+
+	return l
+}
@@ -0,0 +1,135 @@
+// Copyright 2018 gopcua authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package services
+
+import (
+	"time"
+
+	"github.com/wmnsk/gopcua/datatypes"
+)
+
+// AddReferencesRequest is used to add one or more References to one or more Nodes.
+//
+// Specification: Part 4, 5.7.3.2
+type AddReferencesRequest struct {
+	TypeID *datatypes.ExpandedNodeID
+	*RequestHeader
+	ReferencesToAdd *AddReferencesItemArray
+}
+
+// NewAddReferencesRequest creates a new AddReferencesRequest.
+func NewAddReferencesRequest(ts time.Time, authToken datatypes.NodeID, handle, diag, timeout uint32, auditID string, items ...*AddReferencesItem) *AddReferencesRequest {
+	return &AddReferencesRequest{
+		TypeID: datatypes.NewExpandedNodeID(
+			false, false,
+			datatypes.NewFourByteNodeID(
+				0, ServiceTypeAddReferencesRequest,
+			),
+			"", 0,
+		),
+		RequestHeader: NewRequestHeader(
+			authToken,
+			ts,
+			handle,
+			diag,
+			timeout,
+			auditID,
+			NewAdditionalHeader(
+				datatypes.NewExpandedNodeID(
+					false, false,
+					datatypes.NewTwoByteNodeID(0),
+					"", 0,
+				),
+				0x00,
+			),
+			nil,
+		),
+		ReferencesToAdd: NewAddReferencesItemArray(items),
+	}
+}
+
+// DecodeAddReferencesRequest decodes given bytes into AddReferencesRequest.
+func DecodeAddReferencesRequest(b []byte) (*AddReferencesRequest, error) {
+	a := &AddReferencesRequest{}
+	if err := a.DecodeFromBytes(b); err != nil {
+		return nil, err
+	}
+
+	return a, nil
+}
+
+// DecodeFromBytes decodes given bytes into AddReferencesRequest.
+func (a *AddReferencesRequest) DecodeFromBytes(b []byte) error {
+	offset := 0
+	a.TypeID = &datatypes.ExpandedNodeID{}
+	if err := a.TypeID.DecodeFromBytes(b[offset:]); err != nil {
+		return err
+	}
+	offset += a.TypeID.Len()
+
+	a.RequestHeader = &RequestHeader{}
+	if err := a.RequestHeader.DecodeFromBytes(b[offset:]); err != nil {
+		return err
+	}
+	offset += a.RequestHeader.Len() - len(a.RequestHeader.Payload)
+
+	a.ReferencesToAdd = &AddReferencesItemArray{}
+	return a.ReferencesToAdd.DecodeFromBytes(b[offset:])
+}
+
+// Serialize serializes AddReferencesRequest into bytes.
+func (a *AddReferencesRequest) Serialize() ([]byte, error) {
+	b := make([]byte, a.Len())
+	if err := a.SerializeTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// SerializeTo serializes AddReferencesRequest into bytes.
+func (a *AddReferencesRequest) SerializeTo(b []byte) error {
+	offset := 0
+	if a.TypeID != nil {
+		if err := a.TypeID.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += a.TypeID.Len()
+	}
+
+	if a.RequestHeader != nil {
+		if err := a.RequestHeader.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += a.RequestHeader.Len() - len(a.Payload)
+	}
+
+	if a.ReferencesToAdd != nil {
+		return a.ReferencesToAdd.SerializeTo(b[offset:])
+	}
+
+	return nil
+}
+
+// Len returns the actual length of AddReferencesRequest in int.
+func (a *AddReferencesRequest) Len() int {
+	l := 0
+	if a.TypeID != nil {
+		l += a.TypeID.Len()
+	}
+	if a.RequestHeader != nil {
+		l += (a.RequestHeader.Len() - len(a.Payload))
+	}
+	if a.ReferencesToAdd != nil {
+		l += a.ReferencesToAdd.Len()
+	}
+
+	return l
+}
+
+// ServiceType returns type of Service in uint16.
+func (a *AddReferencesRequest) ServiceType() uint16 {
+	return ServiceTypeAddReferencesRequest
+}
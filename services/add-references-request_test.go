@@ -0,0 +1,121 @@
+// Copyright 2018 gopcua authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/wmnsk/gopcua/datatypes"
+	"github.com/wmnsk/gopcua/id"
+)
+
+var addReferencesRequestCases = []struct {
+	description string
+	structured  *AddReferencesRequest
+	serialized  []byte
+}{
+	{
+		"normal",
+		NewAddReferencesRequest(
+			time.Date(2018, time.August, 10, 23, 0, 0, 0, time.UTC),
+			datatypes.NewTwoByteNodeID(0), 1, 0, 0, "",
+			NewAddReferencesItem(
+				datatypes.NewFourByteNodeID(1, 1000),
+				datatypes.NewFourByteNodeID(0, id.Organizes),
+				true, "",
+				datatypes.NewExpandedNodeID(false, false, datatypes.NewFourByteNodeID(1, 1001), "", 0),
+				NodeClassVariable,
+			),
+		),
+		[]byte{ // AddReferencesRequest
+			// TypeID
+			0x01, 0x00, 0xee, 0x01,
+			// RequestHeader
+			// AuthenticationToken
+			0x00, 0x00,
+			// Timestamp
+			0x00, 0x98, 0x67, 0xdd, 0xfd, 0x30, 0xd4, 0x01,
+			// RequestHandle
+			0x01, 0x00, 0x00, 0x00,
+			// ReturnDiagnostics
+			0x00, 0x00, 0x00, 0x00,
+			// AuditEntryID
+			0xff, 0xff, 0xff, 0xff,
+			// TimeoutHint
+			0x00, 0x00, 0x00, 0x00,
+			// AdditionalHeader
+			0x00, 0x00, 0x00,
+			// ReferencesToAdd
+			// ArraySize
+			0x01, 0x00, 0x00, 0x00,
+			// SourceNodeID
+			0x01, 0x01, 0xe8, 0x03,
+			// ReferenceTypeID
+			0x01, 0x00, 0x23, 0x00,
+			// IsForward
+			0x01,
+			// TargetServerURI
+			0xff, 0xff, 0xff, 0xff,
+			// TargetNodeID
+			0x01, 0x01, 0xe9, 0x03,
+			// TargetNodeClass
+			0x02, 0x00, 0x00, 0x00,
+		},
+	},
+}
+
+func TestDecodeAddReferencesRequest(t *testing.T) {
+	for _, c := range addReferencesRequestCases {
+		got, err := DecodeAddReferencesRequest(c.serialized)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// need to clear Payload here.
+		got.Payload = nil
+
+		if diff := cmp.Diff(got, c.structured, decodeCmpOpt); diff != "" {
+			t.Errorf("%s failed\n%s", c.description, diff)
+		}
+	}
+}
+
+func TestSerializeAddReferencesRequest(t *testing.T) {
+	for _, c := range addReferencesRequestCases {
+		got, err := c.structured.Serialize()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if diff := cmp.Diff(got, c.serialized); diff != "" {
+			t.Errorf("%s failed\n%s", c.description, diff)
+		}
+	}
+}
+
+func TestAddReferencesRequestLen(t *testing.T) {
+	for _, c := range addReferencesRequestCases {
+		got := c.structured.Len()
+
+		if diff := cmp.Diff(got, len(c.serialized)); diff != "" {
+			t.Errorf("%s failed\n%s", c.description, diff)
+		}
+	}
+}
+
+func TestAddReferencesRequestServiceType(t *testing.T) {
+	for _, c := range addReferencesRequestCases {
+		if c.structured.ServiceType() != ServiceTypeAddReferencesRequest {
+			t.Errorf(
+				"ServiceType doesn't match. Want: %d, Got: %d",
+				ServiceTypeAddReferencesRequest,
+				c.structured.ServiceType(),
+			)
+		}
+	}
+}
@@ -0,0 +1,153 @@
+// Copyright 2018 gopcua authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package services
+
+import (
+	"time"
+
+	"github.com/wmnsk/gopcua/datatypes"
+)
+
+// AddReferencesResponse represents the response to an AddReferencesRequest.
+// Results are the StatusCodes in the same order as the items in the request.
+//
+// Specification: Part 4, 5.7.3.2
+type AddReferencesResponse struct {
+	TypeID *datatypes.ExpandedNodeID
+	*ResponseHeader
+	Results         *datatypes.Uint32Array
+	DiagnosticInfos *DiagnosticInfoArray
+}
+
+// NewAddReferencesResponse creates a new AddReferencesResponse.
+func NewAddReferencesResponse(ts time.Time, handle, code uint32, diag *DiagnosticInfo, strs []string, results []uint32, diags []*DiagnosticInfo) *AddReferencesResponse {
+	return &AddReferencesResponse{
+		TypeID: datatypes.NewExpandedNodeID(
+			false, false,
+			datatypes.NewFourByteNodeID(
+				0, ServiceTypeAddReferencesResponse,
+			),
+			"", 0,
+		),
+		ResponseHeader: NewResponseHeader(
+			ts,
+			handle,
+			code,
+			diag,
+			strs,
+			NewAdditionalHeader(
+				datatypes.NewExpandedNodeID(
+					false, false,
+					datatypes.NewTwoByteNodeID(0),
+					"", 0,
+				),
+				0x00,
+			),
+			nil,
+		),
+		Results:         datatypes.NewUint32Array(results),
+		DiagnosticInfos: NewDiagnosticInfoArray(diags),
+	}
+}
+
+// DecodeAddReferencesResponse decodes given bytes into AddReferencesResponse.
+func DecodeAddReferencesResponse(b []byte) (*AddReferencesResponse, error) {
+	a := &AddReferencesResponse{}
+	if err := a.DecodeFromBytes(b); err != nil {
+		return nil, err
+	}
+
+	return a, nil
+}
+
+// DecodeFromBytes decodes given bytes into AddReferencesResponse.
+func (a *AddReferencesResponse) DecodeFromBytes(b []byte) error {
+	offset := 0
+	a.TypeID = &datatypes.ExpandedNodeID{}
+	if err := a.TypeID.DecodeFromBytes(b[offset:]); err != nil {
+		return err
+	}
+	offset += a.TypeID.Len()
+
+	a.ResponseHeader = &ResponseHeader{}
+	if err := a.ResponseHeader.DecodeFromBytes(b[offset:]); err != nil {
+		return err
+	}
+	offset += a.ResponseHeader.Len() - len(a.ResponseHeader.Payload)
+
+	a.Results = &datatypes.Uint32Array{}
+	if err := a.Results.DecodeFromBytes(b[offset:]); err != nil {
+		return err
+	}
+	offset += a.Results.Len()
+
+	a.DiagnosticInfos = &DiagnosticInfoArray{}
+	return a.DiagnosticInfos.DecodeFromBytes(b[offset:])
+}
+
+// Serialize serializes AddReferencesResponse into bytes.
+func (a *AddReferencesResponse) Serialize() ([]byte, error) {
+	b := make([]byte, a.Len())
+	if err := a.SerializeTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// SerializeTo serializes AddReferencesResponse into bytes.
+func (a *AddReferencesResponse) SerializeTo(b []byte) error {
+	offset := 0
+	if a.TypeID != nil {
+		if err := a.TypeID.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += a.TypeID.Len()
+	}
+
+	if a.ResponseHeader != nil {
+		if err := a.ResponseHeader.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += a.ResponseHeader.Len() - len(a.Payload)
+	}
+
+	if a.Results != nil {
+		if err := a.Results.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += a.Results.Len()
+	}
+
+	if a.DiagnosticInfos != nil {
+		return a.DiagnosticInfos.SerializeTo(b[offset:])
+	}
+
+	return nil
+}
+
+// Len returns the actual length of AddReferencesResponse in int.
+func (a *AddReferencesResponse) Len() int {
+	l := 0
+	if a.TypeID != nil {
+		l += a.TypeID.Len()
+	}
+	if a.ResponseHeader != nil {
+		l += (a.ResponseHeader.Len() - len(a.Payload))
+	}
+	if a.Results != nil {
+		l += a.Results.Len()
+	}
+	if a.DiagnosticInfos != nil {
+		l += a.DiagnosticInfos.Len()
+	}
+
+	return l
+}
+
+// ServiceType returns type of Service in uint16.
+func (a *AddReferencesResponse) ServiceType() uint16 {
+	return ServiceTypeAddReferencesResponse
+}
@@ -0,0 +1,263 @@
+// Copyright 2018 gopcua authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package services
+
+import (
+	"encoding/binary"
+
+	"github.com/wmnsk/gopcua/datatypes"
+	"github.com/wmnsk/gopcua/errors"
+)
+
+// BrowseDirection specifies the direction of References to follow in Browse.
+//
+// Specification: Part 4, 7.5
+type BrowseDirection uint32
+
+// BrowseDirection definitions.
+const (
+	BrowseDirectionForward BrowseDirection = iota
+	BrowseDirectionInverse
+	BrowseDirectionBoth
+)
+
+// BrowseResultMask definitions, which specify the fields in ReferenceDescription
+// to be returned. They can be combined with bitwise OR.
+//
+// Specification: Part 4, 5.8.2.2
+const (
+	BrowseResultMaskNone            uint32 = 0x00
+	BrowseResultMaskReferenceTypeID uint32 = 0x01
+	BrowseResultMaskIsForward       uint32 = 0x02
+	BrowseResultMaskNodeClass       uint32 = 0x04
+	BrowseResultMaskBrowseName      uint32 = 0x08
+	BrowseResultMaskDisplayName     uint32 = 0x10
+	BrowseResultMaskTypeDefinition  uint32 = 0x20
+	BrowseResultMaskAll             uint32 = 0x3f
+)
+
+// BrowseDescription specifies a Node to be browsed and the References to return.
+//
+// Specification: Part 4, 5.8.2.2
+type BrowseDescription struct {
+	NodeID          datatypes.NodeID
+	BrowseDirection BrowseDirection
+	ReferenceTypeID datatypes.NodeID
+	IncludeSubtypes *datatypes.Boolean
+
+	// 0 means all NodeClasses are returned.
+	NodeClassMask uint32
+
+	// Specifies the fields in ReferenceDescription to be returned.
+	ResultMask uint32
+}
+
+// NewBrowseDescription creates a new BrowseDescription.
+func NewBrowseDescription(nodeID datatypes.NodeID, dir BrowseDirection, refTypeID datatypes.NodeID, includeSubtypes bool, nodeClassMask uint32, resultMask uint32) *BrowseDescription {
+	return &BrowseDescription{
+		NodeID:          nodeID,
+		BrowseDirection: dir,
+		ReferenceTypeID: refTypeID,
+		IncludeSubtypes: datatypes.NewBoolean(includeSubtypes),
+		NodeClassMask:   nodeClassMask,
+		ResultMask:      resultMask,
+	}
+}
+
+// DecodeBrowseDescription decodes given bytes into BrowseDescription.
+func DecodeBrowseDescription(b []byte) (*BrowseDescription, error) {
+	d := &BrowseDescription{}
+	if err := d.DecodeFromBytes(b); err != nil {
+		return nil, err
+	}
+
+	return d, nil
+}
+
+// DecodeFromBytes decodes given bytes into BrowseDescription.
+func (d *BrowseDescription) DecodeFromBytes(b []byte) error {
+	nodeID, err := datatypes.DecodeNodeID(b)
+	if err != nil {
+		return err
+	}
+	d.NodeID = nodeID
+	offset := d.NodeID.Len()
+
+	d.BrowseDirection = BrowseDirection(binary.LittleEndian.Uint32(b[offset : offset+4]))
+	offset += 4
+
+	referenceTypeID, err := datatypes.DecodeNodeID(b[offset:])
+	if err != nil {
+		return err
+	}
+	d.ReferenceTypeID = referenceTypeID
+	offset += d.ReferenceTypeID.Len()
+
+	d.IncludeSubtypes = &datatypes.Boolean{}
+	if err := d.IncludeSubtypes.DecodeFromBytes(b[offset:]); err != nil {
+		return err
+	}
+	offset += d.IncludeSubtypes.Len()
+
+	d.NodeClassMask = binary.LittleEndian.Uint32(b[offset : offset+4])
+	offset += 4
+
+	d.ResultMask = binary.LittleEndian.Uint32(b[offset : offset+4])
+
+	return nil
+}
+
+// Serialize serializes BrowseDescription into bytes.
+func (d *BrowseDescription) Serialize() ([]byte, error) {
+	b := make([]byte, d.Len())
+	if err := d.SerializeTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// SerializeTo serializes BrowseDescription into bytes.
+func (d *BrowseDescription) SerializeTo(b []byte) error {
+	offset := 0
+	if d.NodeID != nil {
+		if err := d.NodeID.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += d.NodeID.Len()
+	}
+
+	binary.LittleEndian.PutUint32(b[offset:offset+4], uint32(d.BrowseDirection))
+	offset += 4
+
+	if d.ReferenceTypeID != nil {
+		if err := d.ReferenceTypeID.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += d.ReferenceTypeID.Len()
+	}
+
+	if d.IncludeSubtypes != nil {
+		if err := d.IncludeSubtypes.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += d.IncludeSubtypes.Len()
+	}
+
+	binary.LittleEndian.PutUint32(b[offset:offset+4], d.NodeClassMask)
+	offset += 4
+
+	binary.LittleEndian.PutUint32(b[offset:offset+4], d.ResultMask)
+
+	return nil
+}
+
+// Len returns the actual length of BrowseDescription in int.
+func (d *BrowseDescription) Len() int {
+	// BrowseDirection + NodeClassMask + ResultMask
+	l := 12
+	if d.NodeID != nil {
+		l += d.NodeID.Len()
+	}
+	if d.ReferenceTypeID != nil {
+		l += d.ReferenceTypeID.Len()
+	}
+	if d.IncludeSubtypes != nil {
+		l += d.IncludeSubtypes.Len()
+	}
+
+	return l
+}
+
+// BrowseDescriptionArray represents an array of BrowseDescriptions.
+// It does not correspond to a certain type from the specification
+// but makes encoding and decoding easier.
+type BrowseDescriptionArray struct {
+	ArraySize     int32
+	NodesToBrowse []*BrowseDescription
+}
+
+// NewBrowseDescriptionArray creates a new BrowseDescriptionArray from multiple BrowseDescriptions.
+func NewBrowseDescriptionArray(nodesToBrowse []*BrowseDescription) *BrowseDescriptionArray {
+	if nodesToBrowse == nil {
+		return &BrowseDescriptionArray{
+			ArraySize: 0,
+		}
+	}
+
+	return &BrowseDescriptionArray{
+		ArraySize:     int32(len(nodesToBrowse)),
+		NodesToBrowse: nodesToBrowse,
+	}
+}
+
+// DecodeBrowseDescriptionArray decodes given bytes into BrowseDescriptionArray.
+func DecodeBrowseDescriptionArray(b []byte) (*BrowseDescriptionArray, error) {
+	d := &BrowseDescriptionArray{}
+	if err := d.DecodeFromBytes(b); err != nil {
+		return nil, err
+	}
+
+	return d, nil
+}
+
+// DecodeFromBytes decodes given bytes into BrowseDescriptionArray.
+func (d *BrowseDescriptionArray) DecodeFromBytes(b []byte) error {
+	if len(b) < 4 {
+		return errors.NewErrTooShortToDecode(d, "should be longer than 4 bytes")
+	}
+
+	d.ArraySize = int32(binary.LittleEndian.Uint32(b[:4]))
+	if d.ArraySize <= 0 {
+		return nil
+	}
+
+	offset := 4
+	for i := 1; i <= int(d.ArraySize); i++ {
+		browseDescription, err := DecodeBrowseDescription(b[offset:])
+		if err != nil {
+			return err
+		}
+		d.NodesToBrowse = append(d.NodesToBrowse, browseDescription)
+		offset += browseDescription.Len()
+	}
+
+	return nil
+}
+
+// Serialize serializes BrowseDescriptionArray into bytes.
+func (d *BrowseDescriptionArray) Serialize() ([]byte, error) {
+	b := make([]byte, d.Len())
+	if err := d.SerializeTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// SerializeTo serializes BrowseDescriptionArray into bytes.
+func (d *BrowseDescriptionArray) SerializeTo(b []byte) error {
+	offset := 4
+	binary.LittleEndian.PutUint32(b[:4], uint32(d.ArraySize))
+
+	for _, browseDescription := range d.NodesToBrowse {
+		if err := browseDescription.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += browseDescription.Len()
+	}
+
+	return nil
+}
+
+// Len returns the actual length of BrowseDescriptionArray in int.
+func (d *BrowseDescriptionArray) Len() int {
+	l := 4
+	for _, browseDescription := range d.NodesToBrowse {
+		l += browseDescription.Len()
+	}
+
+	return l
+}
@@ -0,0 +1,156 @@
+// Copyright 2018 gopcua authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package services
+
+import (
+	"time"
+
+	"github.com/wmnsk/gopcua/datatypes"
+)
+
+// BrowseNextRequest is used to get the rest of the References of the Nodes
+// browsed with BrowseRequest, or to release the ContinuationPoints.
+//
+// Specification: Part 4, 5.8.3.2
+type BrowseNextRequest struct {
+	TypeID *datatypes.ExpandedNodeID
+	*RequestHeader
+
+	// If true, the ContinuationPoints are released and no References are returned.
+	ReleaseContinuationPoints *datatypes.Boolean
+	ContinuationPoints        *datatypes.ByteStringArray
+}
+
+// NewBrowseNextRequest creates a new BrowseNextRequest.
+func NewBrowseNextRequest(ts time.Time, authToken datatypes.NodeID, handle, diag, timeout uint32, auditID string, release bool, cps [][]byte) *BrowseNextRequest {
+	return &BrowseNextRequest{
+		TypeID: datatypes.NewExpandedNodeID(
+			false, false,
+			datatypes.NewFourByteNodeID(
+				0, ServiceTypeBrowseNextRequest,
+			),
+			"", 0,
+		),
+		RequestHeader: NewRequestHeader(
+			authToken,
+			ts,
+			handle,
+			diag,
+			timeout,
+			auditID,
+			NewAdditionalHeader(
+				datatypes.NewExpandedNodeID(
+					false, false,
+					datatypes.NewTwoByteNodeID(0),
+					"", 0,
+				),
+				0x00,
+			),
+			nil,
+		),
+		ReleaseContinuationPoints: datatypes.NewBoolean(release),
+		ContinuationPoints:        datatypes.NewByteStringArray(cps),
+	}
+}
+
+// DecodeBrowseNextRequest decodes given bytes into BrowseNextRequest.
+func DecodeBrowseNextRequest(b []byte) (*BrowseNextRequest, error) {
+	r := &BrowseNextRequest{}
+	if err := r.DecodeFromBytes(b); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// DecodeFromBytes decodes given bytes into BrowseNextRequest.
+func (r *BrowseNextRequest) DecodeFromBytes(b []byte) error {
+	offset := 0
+	r.TypeID = &datatypes.ExpandedNodeID{}
+	if err := r.TypeID.DecodeFromBytes(b[offset:]); err != nil {
+		return err
+	}
+	offset += r.TypeID.Len()
+
+	r.RequestHeader = &RequestHeader{}
+	if err := r.RequestHeader.DecodeFromBytes(b[offset:]); err != nil {
+		return err
+	}
+	offset += r.RequestHeader.Len() - len(r.RequestHeader.Payload)
+
+	r.ReleaseContinuationPoints = &datatypes.Boolean{}
+	if err := r.ReleaseContinuationPoints.DecodeFromBytes(b[offset:]); err != nil {
+		return err
+	}
+	offset += r.ReleaseContinuationPoints.Len()
+
+	r.ContinuationPoints = &datatypes.ByteStringArray{}
+	return r.ContinuationPoints.DecodeFromBytes(b[offset:])
+}
+
+// Serialize serializes BrowseNextRequest into bytes.
+func (r *BrowseNextRequest) Serialize() ([]byte, error) {
+	b := make([]byte, r.Len())
+	if err := r.SerializeTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// SerializeTo serializes BrowseNextRequest into bytes.
+func (r *BrowseNextRequest) SerializeTo(b []byte) error {
+	offset := 0
+	if r.TypeID != nil {
+		if err := r.TypeID.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += r.TypeID.Len()
+	}
+
+	if r.RequestHeader != nil {
+		if err := r.RequestHeader.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += r.RequestHeader.Len() - len(r.Payload)
+	}
+
+	if r.ReleaseContinuationPoints != nil {
+		if err := r.ReleaseContinuationPoints.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += r.ReleaseContinuationPoints.Len()
+	}
+
+	if r.ContinuationPoints != nil {
+		return r.ContinuationPoints.SerializeTo(b[offset:])
+	}
+
+	return nil
+}
+
+// Len returns the actual length of BrowseNextRequest in int.
+func (r *BrowseNextRequest) Len() int {
+	l := 0
+	if r.TypeID != nil {
+		l += r.TypeID.Len()
+	}
+	if r.RequestHeader != nil {
+		l += (r.RequestHeader.Len() - len(r.Payload))
+	}
+	if r.ReleaseContinuationPoints != nil {
+		l += r.ReleaseContinuationPoints.Len()
+	}
+	if r.ContinuationPoints != nil {
+		l += r.ContinuationPoints.Len()
+	}
+
+	return l
+}
+
+// ServiceType returns type of Service in uint16.
+func (r *BrowseNextRequest) ServiceType() uint16 {
+	return ServiceTypeBrowseNextRequest
+}
@@ -0,0 +1,152 @@
+// Copyright 2018 gopcua authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package services
+
+import (
+	"time"
+
+	"github.com/wmnsk/gopcua/datatypes"
+)
+
+// BrowseNextResponse represents the response to a BrowseNextRequest.
+//
+// Specification: Part 4, 5.8.3.2
+type BrowseNextResponse struct {
+	TypeID *datatypes.ExpandedNodeID
+	*ResponseHeader
+	Results         *BrowseResultArray
+	DiagnosticInfos *DiagnosticInfoArray
+}
+
+// NewBrowseNextResponse creates a new BrowseNextResponse.
+func NewBrowseNextResponse(ts time.Time, handle, code uint32, diag *DiagnosticInfo, strs []string, results []*BrowseResult, diags []*DiagnosticInfo) *BrowseNextResponse {
+	return &BrowseNextResponse{
+		TypeID: datatypes.NewExpandedNodeID(
+			false, false,
+			datatypes.NewFourByteNodeID(
+				0, ServiceTypeBrowseNextResponse,
+			),
+			"", 0,
+		),
+		ResponseHeader: NewResponseHeader(
+			ts,
+			handle,
+			code,
+			diag,
+			strs,
+			NewAdditionalHeader(
+				datatypes.NewExpandedNodeID(
+					false, false,
+					datatypes.NewTwoByteNodeID(0),
+					"", 0,
+				),
+				0x00,
+			),
+			nil,
+		),
+		Results:         NewBrowseResultArray(results),
+		DiagnosticInfos: NewDiagnosticInfoArray(diags),
+	}
+}
+
+// DecodeBrowseNextResponse decodes given bytes into BrowseNextResponse.
+func DecodeBrowseNextResponse(b []byte) (*BrowseNextResponse, error) {
+	r := &BrowseNextResponse{}
+	if err := r.DecodeFromBytes(b); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// DecodeFromBytes decodes given bytes into BrowseNextResponse.
+func (r *BrowseNextResponse) DecodeFromBytes(b []byte) error {
+	offset := 0
+	r.TypeID = &datatypes.ExpandedNodeID{}
+	if err := r.TypeID.DecodeFromBytes(b[offset:]); err != nil {
+		return err
+	}
+	offset += r.TypeID.Len()
+
+	r.ResponseHeader = &ResponseHeader{}
+	if err := r.ResponseHeader.DecodeFromBytes(b[offset:]); err != nil {
+		return err
+	}
+	offset += r.ResponseHeader.Len() - len(r.ResponseHeader.Payload)
+
+	r.Results = &BrowseResultArray{}
+	if err := r.Results.DecodeFromBytes(b[offset:]); err != nil {
+		return err
+	}
+	offset += r.Results.Len()
+
+	r.DiagnosticInfos = &DiagnosticInfoArray{}
+	return r.DiagnosticInfos.DecodeFromBytes(b[offset:])
+}
+
+// Serialize serializes BrowseNextResponse into bytes.
+func (r *BrowseNextResponse) Serialize() ([]byte, error) {
+	b := make([]byte, r.Len())
+	if err := r.SerializeTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// SerializeTo serializes BrowseNextResponse into bytes.
+func (r *BrowseNextResponse) SerializeTo(b []byte) error {
+	offset := 0
+	if r.TypeID != nil {
+		if err := r.TypeID.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += r.TypeID.Len()
+	}
+
+	if r.ResponseHeader != nil {
+		if err := r.ResponseHeader.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += r.ResponseHeader.Len() - len(r.Payload)
+	}
+
+	if r.Results != nil {
+		if err := r.Results.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += r.Results.Len()
+	}
+
+	if r.DiagnosticInfos != nil {
+		return r.DiagnosticInfos.SerializeTo(b[offset:])
+	}
+
+	return nil
+}
+
+// Len returns the actual length of BrowseNextResponse in int.
+func (r *BrowseNextResponse) Len() int {
+	l := 0
+	if r.TypeID != nil {
+		l += r.TypeID.Len()
+	}
+	if r.ResponseHeader != nil {
+		l += (r.ResponseHeader.Len() - len(r.Payload))
+	}
+	if r.Results != nil {
+		l += r.Results.Len()
+	}
+	if r.DiagnosticInfos != nil {
+		l += r.DiagnosticInfos.Len()
+	}
+
+	return l
+}
+
+// ServiceType returns type of Service in uint16.
+func (r *BrowseNextResponse) ServiceType() uint16 {
+	return ServiceTypeBrowseNextResponse
+}
@@ -0,0 +1,137 @@
+// Copyright 2018 gopcua authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/wmnsk/gopcua/datatypes"
+	"github.com/wmnsk/gopcua/id"
+)
+
+var browseNextResponseCases = []struct {
+	description string
+	structured  *BrowseNextResponse
+	serialized  []byte
+}{
+	{
+		"normal",
+		NewBrowseNextResponse(
+			time.Date(2018, time.August, 10, 23, 0, 0, 0, time.UTC),
+			1, 0, nil, nil,
+			[]*BrowseResult{
+				NewBrowseResult(0, nil, []*ReferenceDescription{
+					NewReferenceDescription(
+						datatypes.NewFourByteNodeID(0, id.Organizes),
+						true,
+						datatypes.NewExpandedNodeID(false, false, datatypes.NewFourByteNodeID(1, 1001), "", 0),
+						datatypes.NewQualifiedName(1, "foo"),
+						datatypes.NewLocalizedText("", "foo"),
+						NodeClassVariable,
+						datatypes.NewExpandedNodeID(false, false, datatypes.NewFourByteNodeID(0, id.BaseDataVariableType), "", 0),
+					),
+				}),
+			},
+			nil,
+		),
+		[]byte{ // BrowseNextResponse
+			// TypeID
+			0x01, 0x00, 0x18, 0x02,
+			// ResponseHeader
+			// Timestamp
+			0x00, 0x98, 0x67, 0xdd, 0xfd, 0x30, 0xd4, 0x01,
+			// RequestHandle
+			0x01, 0x00, 0x00, 0x00,
+			// ServiceResult
+			0x00, 0x00, 0x00, 0x00,
+			// ServiceDiagnostics
+			0x00,
+			// StringTable
+			0x00, 0x00, 0x00, 0x00,
+			// AdditionalHeader
+			0x00, 0x00, 0x00,
+			// Results
+			// ArraySize
+			0x01, 0x00, 0x00, 0x00,
+			// StatusCode
+			0x00, 0x00, 0x00, 0x00,
+			// ContinuationPoint
+			0xff, 0xff, 0xff, 0xff,
+			// References
+			// ArraySize
+			0x01, 0x00, 0x00, 0x00,
+			// ReferenceTypeID
+			0x01, 0x00, 0x23, 0x00,
+			// IsForward
+			0x01,
+			// NodeID
+			0x01, 0x01, 0xe9, 0x03,
+			// BrowseName
+			0x01, 0x00, 0x03, 0x00, 0x00, 0x00, 0x66, 0x6f, 0x6f,
+			// DisplayName
+			0x02, 0x03, 0x00, 0x00, 0x00, 0x66, 0x6f, 0x6f,
+			// NodeClass
+			0x02, 0x00, 0x00, 0x00,
+			// TypeDefinition
+			0x01, 0x00, 0x3f, 0x00,
+			// DiagnosticInfos
+			0x00, 0x00, 0x00, 0x00,
+		},
+	},
+}
+
+func TestDecodeBrowseNextResponse(t *testing.T) {
+	for _, c := range browseNextResponseCases {
+		got, err := DecodeBrowseNextResponse(c.serialized)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// need to clear Payload here.
+		got.Payload = nil
+
+		if diff := cmp.Diff(got, c.structured, decodeCmpOpt); diff != "" {
+			t.Errorf("%s failed\n%s", c.description, diff)
+		}
+	}
+}
+
+func TestSerializeBrowseNextResponse(t *testing.T) {
+	for _, c := range browseNextResponseCases {
+		got, err := c.structured.Serialize()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if diff := cmp.Diff(got, c.serialized); diff != "" {
+			t.Errorf("%s failed\n%s", c.description, diff)
+		}
+	}
+}
+
+func TestBrowseNextResponseLen(t *testing.T) {
+	for _, c := range browseNextResponseCases {
+		got := c.structured.Len()
+
+		if diff := cmp.Diff(got, len(c.serialized)); diff != "" {
+			t.Errorf("%s failed\n%s", c.description, diff)
+		}
+	}
+}
+
+func TestBrowseNextResponseServiceType(t *testing.T) {
+	for _, c := range browseNextResponseCases {
+		if c.structured.ServiceType() != ServiceTypeBrowseNextResponse {
+			t.Errorf(
+				"ServiceType doesn't match. Want: %d, Got: %d",
+				ServiceTypeBrowseNextResponse,
+				c.structured.ServiceType(),
+			)
+		}
+	}
+}
@@ -0,0 +1,367 @@
+// Copyright 2018 gopcua authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package services
+
+import (
+	"encoding/binary"
+
+	"github.com/wmnsk/gopcua/datatypes"
+	"github.com/wmnsk/gopcua/errors"
+)
+
+// BrowsePathTarget represents a target of a BrowsePath.
+//
+// Specification: Part 4, 5.8.4.2
+type BrowsePathTarget struct {
+	TargetID *datatypes.ExpandedNodeID
+
+	// The index of the first unprocessed element in the RelativePath.
+	// It is set to the max UInt32 value if the RelativePath was fully processed.
+	RemainingPathIndex uint32
+}
+
+// NewBrowsePathTarget creates a new BrowsePathTarget.
+func NewBrowsePathTarget(target *datatypes.ExpandedNodeID, idx uint32) *BrowsePathTarget {
+	return &BrowsePathTarget{
+		TargetID:           target,
+		RemainingPathIndex: idx,
+	}
+}
+
+// DecodeBrowsePathTarget decodes given bytes into BrowsePathTarget.
+func DecodeBrowsePathTarget(b []byte) (*BrowsePathTarget, error) {
+	t := &BrowsePathTarget{}
+	if err := t.DecodeFromBytes(b); err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+// DecodeFromBytes decodes given bytes into BrowsePathTarget.
+func (t *BrowsePathTarget) DecodeFromBytes(b []byte) error {
+	t.TargetID = &datatypes.ExpandedNodeID{}
+	if err := t.TargetID.DecodeFromBytes(b); err != nil {
+		return err
+	}
+	offset := t.TargetID.Len()
+
+	if len(b[offset:]) < 4 {
+		return errors.NewErrTooShortToDecode(t, "should have 4 bytes after TargetID")
+	}
+	t.RemainingPathIndex = binary.LittleEndian.Uint32(b[offset : offset+4])
+
+	return nil
+}
+
+// Serialize serializes BrowsePathTarget into bytes.
+func (t *BrowsePathTarget) Serialize() ([]byte, error) {
+	b := make([]byte, t.Len())
+	if err := t.SerializeTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// SerializeTo serializes BrowsePathTarget into bytes.
+func (t *BrowsePathTarget) SerializeTo(b []byte) error {
+	offset := 0
+	if t.TargetID != nil {
+		if err := t.TargetID.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += t.TargetID.Len()
+	}
+
+	binary.LittleEndian.PutUint32(b[offset:offset+4], t.RemainingPathIndex)
+
+	return nil
+}
+
+// Len returns the actual length of BrowsePathTarget in int.
+func (t *BrowsePathTarget) Len() int {
+	l := 4
+	if t.TargetID != nil {
+		l += t.TargetID.Len()
+	}
+
+	return l
+}
+
+// BrowsePathTargetArray represents an array of BrowsePathTargets.
+// It does not correspond to a certain type from the specification
+// but makes encoding and decoding easier.
+type BrowsePathTargetArray struct {
+	ArraySize int32
+	Targets   []*BrowsePathTarget
+}
+
+// NewBrowsePathTargetArray creates a new BrowsePathTargetArray from multiple BrowsePathTargets.
+func NewBrowsePathTargetArray(targets []*BrowsePathTarget) *BrowsePathTargetArray {
+	if targets == nil {
+		return &BrowsePathTargetArray{
+			ArraySize: 0,
+		}
+	}
+
+	return &BrowsePathTargetArray{
+		ArraySize: int32(len(targets)),
+		Targets:   targets,
+	}
+}
+
+// DecodeBrowsePathTargetArray decodes given bytes into BrowsePathTargetArray.
+func DecodeBrowsePathTargetArray(b []byte) (*BrowsePathTargetArray, error) {
+	t := &BrowsePathTargetArray{}
+	if err := t.DecodeFromBytes(b); err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+// DecodeFromBytes decodes given bytes into BrowsePathTargetArray.
+func (t *BrowsePathTargetArray) DecodeFromBytes(b []byte) error {
+	if len(b) < 4 {
+		return errors.NewErrTooShortToDecode(t, "should be longer than 4 bytes")
+	}
+
+	t.ArraySize = int32(binary.LittleEndian.Uint32(b[:4]))
+	if t.ArraySize <= 0 {
+		return nil
+	}
+
+	offset := 4
+	for i := 1; i <= int(t.ArraySize); i++ {
+		target, err := DecodeBrowsePathTarget(b[offset:])
+		if err != nil {
+			return err
+		}
+		t.Targets = append(t.Targets, target)
+		offset += target.Len()
+	}
+
+	return nil
+}
+
+// Serialize serializes BrowsePathTargetArray into bytes.
+func (t *BrowsePathTargetArray) Serialize() ([]byte, error) {
+	b := make([]byte, t.Len())
+	if err := t.SerializeTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// SerializeTo serializes BrowsePathTargetArray into bytes.
+func (t *BrowsePathTargetArray) SerializeTo(b []byte) error {
+	offset := 4
+	binary.LittleEndian.PutUint32(b[:4], uint32(t.ArraySize))
+
+	for _, target := range t.Targets {
+		if err := target.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += target.Len()
+	}
+
+	return nil
+}
+
+// Len returns the actual length of BrowsePathTargetArray in int.
+func (t *BrowsePathTargetArray) Len() int {
+	l := 4
+	for _, target := range t.Targets {
+		l += target.Len()
+	}
+
+	return l
+}
+
+// BrowsePathResult represents the result of translating a BrowsePath.
+//
+// Specification: Part 4, 5.8.4.2
+type BrowsePathResult struct {
+	StatusCode uint32
+	Targets    *BrowsePathTargetArray
+}
+
+// NewBrowsePathResult creates a new BrowsePathResult.
+func NewBrowsePathResult(code uint32, targets ...*BrowsePathTarget) *BrowsePathResult {
+	return &BrowsePathResult{
+		StatusCode: code,
+		Targets:    NewBrowsePathTargetArray(targets),
+	}
+}
+
+// DecodeBrowsePathResult decodes given bytes into BrowsePathResult.
+func DecodeBrowsePathResult(b []byte) (*BrowsePathResult, error) {
+	r := &BrowsePathResult{}
+	if err := r.DecodeFromBytes(b); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// DecodeFromBytes decodes given bytes into BrowsePathResult.
+func (r *BrowsePathResult) DecodeFromBytes(b []byte) error {
+	if len(b) < 8 {
+		return errors.NewErrTooShortToDecode(r, "should be longer than 8 bytes")
+	}
+	r.StatusCode = binary.LittleEndian.Uint32(b[:4])
+
+	r.Targets = &BrowsePathTargetArray{}
+	return r.Targets.DecodeFromBytes(b[4:])
+}
+
+// Serialize serializes BrowsePathResult into bytes.
+func (r *BrowsePathResult) Serialize() ([]byte, error) {
+	b := make([]byte, r.Len())
+	if err := r.SerializeTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// SerializeTo serializes BrowsePathResult into bytes.
+func (r *BrowsePathResult) SerializeTo(b []byte) error {
+	binary.LittleEndian.PutUint32(b[:4], r.StatusCode)
+
+	if r.Targets != nil {
+		return r.Targets.SerializeTo(b[4:])
+	}
+
+	return nil
+}
+
+// Len returns the actual length of BrowsePathResult in int.
+func (r *BrowsePathResult) Len() int {
+	l := 4
+	if r.Targets != nil {
+		l += r.Targets.Len()
+	}
+
+	return l
+}
+
+// Target returns the NodeID the BrowsePath resolved to.
+//
+// It returns an error if the StatusCode is not Good, or if the BrowsePath
+// resolved to no target or to more than one.
+func (r *BrowsePathResult) Target() (*datatypes.ExpandedNodeID, error) {
+	if r.StatusCode != 0 {
+		return nil, errors.Errorf("failed to translate browse path: status 0x%08x", r.StatusCode)
+	}
+
+	if r.Targets == nil || len(r.Targets.Targets) == 0 {
+		return nil, ErrNoBrowsePathTarget
+	}
+	if len(r.Targets.Targets) > 1 {
+		return nil, ErrMultipleBrowsePathTargets
+	}
+
+	return r.Targets.Targets[0].TargetID, nil
+}
+
+// BrowsePathResultArray represents an array of BrowsePathResults.
+// It does not correspond to a certain type from the specification
+// but makes encoding and decoding easier.
+type BrowsePathResultArray struct {
+	ArraySize int32
+	Results   []*BrowsePathResult
+}
+
+// NewBrowsePathResultArray creates a new BrowsePathResultArray from multiple BrowsePathResults.
+func NewBrowsePathResultArray(results []*BrowsePathResult) *BrowsePathResultArray {
+	if results == nil {
+		return &BrowsePathResultArray{
+			ArraySize: 0,
+		}
+	}
+
+	return &BrowsePathResultArray{
+		ArraySize: int32(len(results)),
+		Results:   results,
+	}
+}
+
+// DecodeBrowsePathResultArray decodes given bytes into BrowsePathResultArray.
+func DecodeBrowsePathResultArray(b []byte) (*BrowsePathResultArray, error) {
+	r := &BrowsePathResultArray{}
+	if err := r.DecodeFromBytes(b); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// DecodeFromBytes decodes given bytes into BrowsePathResultArray.
+func (r *BrowsePathResultArray) DecodeFromBytes(b []byte) error {
+	if len(b) < 4 {
+		return errors.NewErrTooShortToDecode(r, "should be longer than 4 bytes")
+	}
+
+	r.ArraySize = int32(binary.LittleEndian.Uint32(b[:4]))
+	if r.ArraySize <= 0 {
+		return nil
+	}
+
+	offset := 4
+	for i := 1; i <= int(r.ArraySize); i++ {
+		result, err := DecodeBrowsePathResult(b[offset:])
+		if err != nil {
+			return err
+		}
+		r.Results = append(r.Results, result)
+		offset += result.Len()
+	}
+
+	return nil
+}
+
+// Serialize serializes BrowsePathResultArray into bytes.
+func (r *BrowsePathResultArray) Serialize() ([]byte, error) {
+	b := make([]byte, r.Len())
+	if err := r.SerializeTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// SerializeTo serializes BrowsePathResultArray into bytes.
+func (r *BrowsePathResultArray) SerializeTo(b []byte) error {
+	offset := 4
+	binary.LittleEndian.PutUint32(b[:4], uint32(r.ArraySize))
+
+	for _, result := range r.Results {
+		if err := result.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += result.Len()
+	}
+
+	return nil
+}
+
+// Len returns the actual length of BrowsePathResultArray in int.
+func (r *BrowsePathResultArray) Len() int {
+	l := 4
+	for _, result := range r.Results {
+		l += result.Len()
+	}
+
+	return l
+}
+
+// Errors returned when a BrowsePathResult does not point to a single node.
+var (
+	ErrNoBrowsePathTarget        = errors.New("browse path resolved to no target")
+	ErrMultipleBrowsePathTargets = errors.New("browse path resolved to multiple targets")
+)
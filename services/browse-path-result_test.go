@@ -0,0 +1,122 @@
+// Copyright 2018 gopcua authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package services
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/wmnsk/gopcua/datatypes"
+	"github.com/wmnsk/gopcua/status"
+)
+
+var browsePathResultCases = []struct {
+	description string
+	structured  *BrowsePathResult
+	serialized  []byte
+}{
+	{
+		"no-target",
+		NewBrowsePathResult(status.BadNoMatch),
+		[]byte{
+			// StatusCode
+			0x00, 0x00, 0x6f, 0x80,
+			// Targets: ArraySize
+			0x00, 0x00, 0x00, 0x00,
+		},
+	},
+	{
+		"single-target",
+		NewBrowsePathResult(
+			0,
+			NewBrowsePathTarget(
+				datatypes.NewExpandedNodeID(
+					false, false,
+					datatypes.NewFourByteNodeID(2, 10),
+					"", 0,
+				),
+				0xffffffff,
+			),
+		),
+		[]byte{
+			// StatusCode
+			0x00, 0x00, 0x00, 0x00,
+			// Targets: ArraySize
+			0x01, 0x00, 0x00, 0x00,
+			// TargetID
+			0x01, 0x02, 0x0a, 0x00,
+			// RemainingPathIndex
+			0xff, 0xff, 0xff, 0xff,
+		},
+	},
+}
+
+func TestDecodeBrowsePathResult(t *testing.T) {
+	for _, c := range browsePathResultCases {
+		got, err := DecodeBrowsePathResult(c.serialized)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if diff := cmp.Diff(got, c.structured, decodeCmpOpt); diff != "" {
+			t.Errorf("%s failed\n%s", c.description, diff)
+		}
+	}
+}
+
+func TestSerializeBrowsePathResult(t *testing.T) {
+	for _, c := range browsePathResultCases {
+		got, err := c.structured.Serialize()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if diff := cmp.Diff(got, c.serialized); diff != "" {
+			t.Errorf("%s failed\n%s", c.description, diff)
+		}
+	}
+}
+
+func TestBrowsePathResultLen(t *testing.T) {
+	for _, c := range browsePathResultCases {
+		got := c.structured.Len()
+
+		if diff := cmp.Diff(got, len(c.serialized)); diff != "" {
+			t.Errorf("%s failed\n%s", c.description, diff)
+		}
+	}
+}
+
+func TestBrowsePathResultTarget(t *testing.T) {
+	target := func(i uint16) *BrowsePathTarget {
+		return NewBrowsePathTarget(
+			datatypes.NewExpandedNodeID(
+				false, false,
+				datatypes.NewFourByteNodeID(2, i),
+				"", 0,
+			),
+			0xffffffff,
+		)
+	}
+
+	got, err := NewBrowsePathResult(0, target(10)).Target()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(got, target(10).TargetID); diff != "" {
+		t.Error(diff)
+	}
+
+	if _, err := NewBrowsePathResult(0).Target(); err != ErrNoBrowsePathTarget {
+		t.Errorf("got %v, want %v", err, ErrNoBrowsePathTarget)
+	}
+	if _, err := NewBrowsePathResult(0, target(10), target(11)).Target(); err != ErrMultipleBrowsePathTargets {
+		t.Errorf("got %v, want %v", err, ErrMultipleBrowsePathTargets)
+	}
+	if _, err := NewBrowsePathResult(status.BadNoMatch).Target(); err == nil {
+		t.Error("expected error for bad status")
+	}
+}
@@ -0,0 +1,182 @@
+// Copyright 2018 gopcua authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package services
+
+import (
+	"encoding/binary"
+
+	"github.com/wmnsk/gopcua/datatypes"
+	"github.com/wmnsk/gopcua/errors"
+)
+
+// BrowsePath represents a path to follow from the StartingNode.
+//
+// Specification: Part 4, 5.8.4.2
+type BrowsePath struct {
+	StartingNode datatypes.NodeID
+	RelativePath *RelativePath
+}
+
+// NewBrowsePath creates a new BrowsePath.
+func NewBrowsePath(start datatypes.NodeID, path *RelativePath) *BrowsePath {
+	return &BrowsePath{
+		StartingNode: start,
+		RelativePath: path,
+	}
+}
+
+// DecodeBrowsePath decodes given bytes into BrowsePath.
+func DecodeBrowsePath(b []byte) (*BrowsePath, error) {
+	p := &BrowsePath{}
+	if err := p.DecodeFromBytes(b); err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+// DecodeFromBytes decodes given bytes into BrowsePath.
+func (p *BrowsePath) DecodeFromBytes(b []byte) error {
+	start, err := datatypes.DecodeNodeID(b)
+	if err != nil {
+		return err
+	}
+	p.StartingNode = start
+	offset := p.StartingNode.Len()
+
+	p.RelativePath = &RelativePath{}
+	return p.RelativePath.DecodeFromBytes(b[offset:])
+}
+
+// Serialize serializes BrowsePath into bytes.
+func (p *BrowsePath) Serialize() ([]byte, error) {
+	b := make([]byte, p.Len())
+	if err := p.SerializeTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// SerializeTo serializes BrowsePath into bytes.
+func (p *BrowsePath) SerializeTo(b []byte) error {
+	offset := 0
+	if p.StartingNode != nil {
+		if err := p.StartingNode.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += p.StartingNode.Len()
+	}
+
+	if p.RelativePath != nil {
+		return p.RelativePath.SerializeTo(b[offset:])
+	}
+
+	return nil
+}
+
+// Len returns the actual length of BrowsePath in int.
+func (p *BrowsePath) Len() int {
+	l := 0
+	if p.StartingNode != nil {
+		l += p.StartingNode.Len()
+	}
+	if p.RelativePath != nil {
+		l += p.RelativePath.Len()
+	}
+
+	return l
+}
+
+// BrowsePathArray represents an array of BrowsePaths.
+// It does not correspond to a certain type from the specification
+// but makes encoding and decoding easier.
+type BrowsePathArray struct {
+	ArraySize   int32
+	BrowsePaths []*BrowsePath
+}
+
+// NewBrowsePathArray creates a new BrowsePathArray from multiple BrowsePaths.
+func NewBrowsePathArray(paths []*BrowsePath) *BrowsePathArray {
+	if paths == nil {
+		return &BrowsePathArray{
+			ArraySize: 0,
+		}
+	}
+
+	return &BrowsePathArray{
+		ArraySize:   int32(len(paths)),
+		BrowsePaths: paths,
+	}
+}
+
+// DecodeBrowsePathArray decodes given bytes into BrowsePathArray.
+func DecodeBrowsePathArray(b []byte) (*BrowsePathArray, error) {
+	p := &BrowsePathArray{}
+	if err := p.DecodeFromBytes(b); err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+// DecodeFromBytes decodes given bytes into BrowsePathArray.
+func (p *BrowsePathArray) DecodeFromBytes(b []byte) error {
+	if len(b) < 4 {
+		return errors.NewErrTooShortToDecode(p, "should be longer than 4 bytes")
+	}
+
+	p.ArraySize = int32(binary.LittleEndian.Uint32(b[:4]))
+	if p.ArraySize <= 0 {
+		return nil
+	}
+
+	offset := 4
+	for i := 1; i <= int(p.ArraySize); i++ {
+		path, err := DecodeBrowsePath(b[offset:])
+		if err != nil {
+			return err
+		}
+		p.BrowsePaths = append(p.BrowsePaths, path)
+		offset += path.Len()
+	}
+
+	return nil
+}
+
+// Serialize serializes BrowsePathArray into bytes.
+func (p *BrowsePathArray) Serialize() ([]byte, error) {
+	b := make([]byte, p.Len())
+	if err := p.SerializeTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// SerializeTo serializes BrowsePathArray into bytes.
+func (p *BrowsePathArray) SerializeTo(b []byte) error {
+	offset := 4
+	binary.LittleEndian.PutUint32(b[:4], uint32(p.ArraySize))
+
+	for _, path := range p.BrowsePaths {
+		if err := path.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += path.Len()
+	}
+
+	return nil
+}
+
+// Len returns the actual length of BrowsePathArray in int.
+func (p *BrowsePathArray) Len() int {
+	l := 4
+	for _, path := range p.BrowsePaths {
+		l += path.Len()
+	}
+
+	return l
+}
@@ -0,0 +1,165 @@
+// Copyright 2018 gopcua authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package services
+
+import (
+	"encoding/binary"
+	"time"
+
+	"github.com/wmnsk/gopcua/datatypes"
+)
+
+// BrowseRequest is used to discover the References of one or more Nodes.
+//
+// Specification: Part 4, 5.8.2.2
+type BrowseRequest struct {
+	TypeID *datatypes.ExpandedNodeID
+	*RequestHeader
+	View *ViewDescription
+
+	// 0 means no limitation.
+	RequestedMaxReferencesPerNode uint32
+	NodesToBrowse                 *BrowseDescriptionArray
+}
+
+// NewBrowseRequest creates a new BrowseRequest.
+func NewBrowseRequest(ts time.Time, authToken datatypes.NodeID, handle, diag, timeout uint32, auditID string, view *ViewDescription, maxRefs uint32, nodes ...*BrowseDescription) *BrowseRequest {
+	return &BrowseRequest{
+		TypeID: datatypes.NewExpandedNodeID(
+			false, false,
+			datatypes.NewFourByteNodeID(
+				0, ServiceTypeBrowseRequest,
+			),
+			"", 0,
+		),
+		RequestHeader: NewRequestHeader(
+			authToken,
+			ts,
+			handle,
+			diag,
+			timeout,
+			auditID,
+			NewAdditionalHeader(
+				datatypes.NewExpandedNodeID(
+					false, false,
+					datatypes.NewTwoByteNodeID(0),
+					"", 0,
+				),
+				0x00,
+			),
+			nil,
+		),
+		View:                          view,
+		RequestedMaxReferencesPerNode: maxRefs,
+		NodesToBrowse:                 NewBrowseDescriptionArray(nodes),
+	}
+}
+
+// DecodeBrowseRequest decodes given bytes into BrowseRequest.
+func DecodeBrowseRequest(b []byte) (*BrowseRequest, error) {
+	r := &BrowseRequest{}
+	if err := r.DecodeFromBytes(b); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// DecodeFromBytes decodes given bytes into BrowseRequest.
+func (r *BrowseRequest) DecodeFromBytes(b []byte) error {
+	offset := 0
+	r.TypeID = &datatypes.ExpandedNodeID{}
+	if err := r.TypeID.DecodeFromBytes(b[offset:]); err != nil {
+		return err
+	}
+	offset += r.TypeID.Len()
+
+	r.RequestHeader = &RequestHeader{}
+	if err := r.RequestHeader.DecodeFromBytes(b[offset:]); err != nil {
+		return err
+	}
+	offset += r.RequestHeader.Len() - len(r.RequestHeader.Payload)
+
+	r.View = &ViewDescription{}
+	if err := r.View.DecodeFromBytes(b[offset:]); err != nil {
+		return err
+	}
+	offset += r.View.Len()
+
+	r.RequestedMaxReferencesPerNode = binary.LittleEndian.Uint32(b[offset : offset+4])
+	offset += 4
+
+	r.NodesToBrowse = &BrowseDescriptionArray{}
+	return r.NodesToBrowse.DecodeFromBytes(b[offset:])
+}
+
+// Serialize serializes BrowseRequest into bytes.
+func (r *BrowseRequest) Serialize() ([]byte, error) {
+	b := make([]byte, r.Len())
+	if err := r.SerializeTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// SerializeTo serializes BrowseRequest into bytes.
+func (r *BrowseRequest) SerializeTo(b []byte) error {
+	offset := 0
+	if r.TypeID != nil {
+		if err := r.TypeID.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += r.TypeID.Len()
+	}
+
+	if r.RequestHeader != nil {
+		if err := r.RequestHeader.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += r.RequestHeader.Len() - len(r.Payload)
+	}
+
+	if r.View != nil {
+		if err := r.View.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += r.View.Len()
+	}
+
+	binary.LittleEndian.PutUint32(b[offset:offset+4], r.RequestedMaxReferencesPerNode)
+	offset += 4
+
+	if r.NodesToBrowse != nil {
+		return r.NodesToBrowse.SerializeTo(b[offset:])
+	}
+
+	return nil
+}
+
+// Len returns the actual length of BrowseRequest in int.
+func (r *BrowseRequest) Len() int {
+	// RequestedMaxReferencesPerNode
+	l := 4
+	if r.TypeID != nil {
+		l += r.TypeID.Len()
+	}
+	if r.RequestHeader != nil {
+		l += (r.RequestHeader.Len() - len(r.Payload))
+	}
+	if r.View != nil {
+		l += r.View.Len()
+	}
+	if r.NodesToBrowse != nil {
+		l += r.NodesToBrowse.Len()
+	}
+
+	return l
+}
+
+// ServiceType returns type of Service in uint16.
+func (r *BrowseRequest) ServiceType() uint16 {
+	return ServiceTypeBrowseRequest
+}
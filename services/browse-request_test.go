@@ -0,0 +1,131 @@
+// Copyright 2018 gopcua authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/wmnsk/gopcua/datatypes"
+	"github.com/wmnsk/gopcua/id"
+)
+
+var browseRequestCases = []struct {
+	description string
+	structured  *BrowseRequest
+	serialized  []byte
+}{
+	{
+		"normal",
+		NewBrowseRequest(
+			time.Date(2018, time.August, 10, 23, 0, 0, 0, time.UTC),
+			datatypes.NewTwoByteNodeID(0), 1, 0, 0, "",
+			NewNullViewDescription(),
+			100,
+			NewBrowseDescription(
+				datatypes.NewFourByteNodeID(0, id.ObjectsFolder),
+				BrowseDirectionForward,
+				datatypes.NewFourByteNodeID(0, id.HierarchicalReferences),
+				true, 0, BrowseResultMaskAll,
+			),
+		),
+		[]byte{ // BrowseRequest
+			// TypeID
+			0x01, 0x00, 0x0f, 0x02,
+			// RequestHeader
+			// AuthenticationToken
+			0x00, 0x00,
+			// Timestamp
+			0x00, 0x98, 0x67, 0xdd, 0xfd, 0x30, 0xd4, 0x01,
+			// RequestHandle
+			0x01, 0x00, 0x00, 0x00,
+			// ReturnDiagnostics
+			0x00, 0x00, 0x00, 0x00,
+			// AuditEntryID
+			0xff, 0xff, 0xff, 0xff,
+			// TimeoutHint
+			0x00, 0x00, 0x00, 0x00,
+			// AdditionalHeader
+			0x00, 0x00, 0x00,
+			// View
+			// ViewID
+			0x00, 0x00,
+			// Timestamp
+			0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			// ViewVersion
+			0x00, 0x00, 0x00, 0x00,
+			// RequestedMaxReferencesPerNode
+			0x64, 0x00, 0x00, 0x00,
+			// NodesToBrowse
+			// ArraySize
+			0x01, 0x00, 0x00, 0x00,
+			// NodeID
+			0x01, 0x00, 0x55, 0x00,
+			// BrowseDirection
+			0x00, 0x00, 0x00, 0x00,
+			// ReferenceTypeID
+			0x01, 0x00, 0x21, 0x00,
+			// IncludeSubtypes
+			0x01,
+			// NodeClassMask
+			0x00, 0x00, 0x00, 0x00,
+			// ResultMask
+			0x3f, 0x00, 0x00, 0x00,
+		},
+	},
+}
+
+func TestDecodeBrowseRequest(t *testing.T) {
+	for _, c := range browseRequestCases {
+		got, err := DecodeBrowseRequest(c.serialized)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// need to clear Payload here.
+		got.Payload = nil
+
+		if diff := cmp.Diff(got, c.structured, decodeCmpOpt); diff != "" {
+			t.Errorf("%s failed\n%s", c.description, diff)
+		}
+	}
+}
+
+func TestSerializeBrowseRequest(t *testing.T) {
+	for _, c := range browseRequestCases {
+		got, err := c.structured.Serialize()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if diff := cmp.Diff(got, c.serialized); diff != "" {
+			t.Errorf("%s failed\n%s", c.description, diff)
+		}
+	}
+}
+
+func TestBrowseRequestLen(t *testing.T) {
+	for _, c := range browseRequestCases {
+		got := c.structured.Len()
+
+		if diff := cmp.Diff(got, len(c.serialized)); diff != "" {
+			t.Errorf("%s failed\n%s", c.description, diff)
+		}
+	}
+}
+
+func TestBrowseRequestServiceType(t *testing.T) {
+	for _, c := range browseRequestCases {
+		if c.structured.ServiceType() != ServiceTypeBrowseRequest {
+			t.Errorf(
+				"ServiceType doesn't match. Want: %d, Got: %d",
+				ServiceTypeBrowseRequest,
+				c.structured.ServiceType(),
+			)
+		}
+	}
+}
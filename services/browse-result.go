@@ -0,0 +1,208 @@
+// Copyright 2018 gopcua authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package services
+
+import (
+	"encoding/binary"
+
+	"github.com/wmnsk/gopcua/datatypes"
+	"github.com/wmnsk/gopcua/errors"
+)
+
+// BrowseResult is the result of Browse and BrowseNext for a single Node.
+// If the Server could not return all the References, ContinuationPoint is set
+// and the rest can be retrieved with BrowseNext.
+//
+// Specification: Part 4, 7.3
+type BrowseResult struct {
+	StatusCode        uint32
+	ContinuationPoint *datatypes.ByteString
+	References        *ReferenceDescriptionArray
+}
+
+// NewBrowseResult creates a new BrowseResult.
+func NewBrowseResult(code uint32, cp []byte, refs []*ReferenceDescription) *BrowseResult {
+	return &BrowseResult{
+		StatusCode:        code,
+		ContinuationPoint: datatypes.NewByteString(cp),
+		References:        NewReferenceDescriptionArray(refs),
+	}
+}
+
+// DecodeBrowseResult decodes given bytes into BrowseResult.
+func DecodeBrowseResult(b []byte) (*BrowseResult, error) {
+	r := &BrowseResult{}
+	if err := r.DecodeFromBytes(b); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// DecodeFromBytes decodes given bytes into BrowseResult.
+func (r *BrowseResult) DecodeFromBytes(b []byte) error {
+	offset := 0
+	r.StatusCode = binary.LittleEndian.Uint32(b[offset : offset+4])
+	offset += 4
+
+	r.ContinuationPoint = &datatypes.ByteString{}
+	if err := r.ContinuationPoint.DecodeFromBytes(b[offset:]); err != nil {
+		return err
+	}
+	offset += r.ContinuationPoint.Len()
+
+	r.References = &ReferenceDescriptionArray{}
+	return r.References.DecodeFromBytes(b[offset:])
+}
+
+// Serialize serializes BrowseResult into bytes.
+func (r *BrowseResult) Serialize() ([]byte, error) {
+	b := make([]byte, r.Len())
+	if err := r.SerializeTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// SerializeTo serializes BrowseResult into bytes.
+func (r *BrowseResult) SerializeTo(b []byte) error {
+	offset := 0
+	binary.LittleEndian.PutUint32(b[offset:offset+4], r.StatusCode)
+	offset += 4
+
+	if r.ContinuationPoint != nil {
+		if err := r.ContinuationPoint.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += r.ContinuationPoint.Len()
+	}
+
+	if r.References != nil {
+		return r.References.SerializeTo(b[offset:])
+	}
+
+	return nil
+}
+
+// Len returns the actual length of BrowseResult in int.
+func (r *BrowseResult) Len() int {
+	// StatusCode
+	l := 4
+	if r.ContinuationPoint != nil {
+		l += r.ContinuationPoint.Len()
+	}
+	if r.References != nil {
+		l += r.References.Len()
+	}
+
+	return l
+}
+
+// BrowseResultArray represents an array of BrowseResults.
+// It does not correspond to a certain type from the specification
+// but makes encoding and decoding easier.
+type BrowseResultArray struct {
+	ArraySize     int32
+	BrowseResults []*BrowseResult
+}
+
+// NewBrowseResultArray creates a new BrowseResultArray from multiple BrowseResults.
+func NewBrowseResultArray(browseResults []*BrowseResult) *BrowseResultArray {
+	if browseResults == nil {
+		return &BrowseResultArray{
+			ArraySize: 0,
+		}
+	}
+
+	return &BrowseResultArray{
+		ArraySize:     int32(len(browseResults)),
+		BrowseResults: browseResults,
+	}
+}
+
+// DecodeBrowseResultArray decodes given bytes into BrowseResultArray.
+func DecodeBrowseResultArray(b []byte) (*BrowseResultArray, error) {
+	r := &BrowseResultArray{}
+	if err := r.DecodeFromBytes(b); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// DecodeFromBytes decodes given bytes into BrowseResultArray.
+func (r *BrowseResultArray) DecodeFromBytes(b []byte) error {
+	if len(b) < 4 {
+		return errors.NewErrTooShortToDecode(r, "should be longer than 4 bytes")
+	}
+
+	r.ArraySize = int32(binary.LittleEndian.Uint32(b[:4]))
+	if r.ArraySize <= 0 {
+		return nil
+	}
+
+	offset := 4
+	for i := 1; i <= int(r.ArraySize); i++ {
+		browseResult, err := DecodeBrowseResult(b[offset:])
+		if err != nil {
+			return err
+		}
+		r.BrowseResults = append(r.BrowseResults, browseResult)
+		offset += browseResult.Len()
+	}
+
+	return nil
+}
+
+// Serialize serializes BrowseResultArray into bytes.
+func (r *BrowseResultArray) Serialize() ([]byte, error) {
+	b := make([]byte, r.Len())
+	if err := r.SerializeTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// SerializeTo serializes BrowseResultArray into bytes.
+func (r *BrowseResultArray) SerializeTo(b []byte) error {
+	offset := 4
+	binary.LittleEndian.PutUint32(b[:4], uint32(r.ArraySize))
+
+	for _, browseResult := range r.BrowseResults {
+		if err := browseResult.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += browseResult.Len()
+	}
+
+	return nil
+}
+
+// Len returns the actual length of BrowseResultArray in int.
+func (r *BrowseResultArray) Len() int {
+	l := 4
+	for _, browseResult := range r.BrowseResults {
+		l += browseResult.Len()
+	}
+
+	return l
+}
+
+// ContinuationPoints returns the ContinuationPoints of the BrowseResults that
+// have more References to be returned, in the same order as the results.
+// It can be passed to NewBrowseNextRequest as it is.
+func (r *BrowseResultArray) ContinuationPoints() [][]byte {
+	var cps [][]byte
+	for _, res := range r.BrowseResults {
+		if res.ContinuationPoint == nil || len(res.ContinuationPoint.Get()) == 0 {
+			continue
+		}
+		cps = append(cps, res.ContinuationPoint.Get())
+	}
+
+	return cps
+}
@@ -0,0 +1,101 @@
+// Copyright 2018 gopcua authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/wmnsk/gopcua/datatypes"
+)
+
+var cancelRequestCases = []struct {
+	description string
+	structured  *CancelRequest
+	serialized  []byte
+}{
+	{
+		"normal",
+		NewCancelRequest(
+			time.Date(2018, time.August, 10, 23, 0, 0, 0, time.UTC),
+			datatypes.NewTwoByteNodeID(0), 1, 0, 0, "",
+			0x10,
+		),
+		[]byte{ // CancelRequest
+			// TypeID
+			0x01, 0x00, 0xdf, 0x01,
+			// RequestHeader
+			// AuthenticationToken
+			0x00, 0x00,
+			// Timestamp
+			0x00, 0x98, 0x67, 0xdd, 0xfd, 0x30, 0xd4, 0x01,
+			// RequestHandle
+			0x01, 0x00, 0x00, 0x00,
+			// ReturnDiagnostics
+			0x00, 0x00, 0x00, 0x00,
+			// AuditEntryID
+			0xff, 0xff, 0xff, 0xff,
+			// TimeoutHint
+			0x00, 0x00, 0x00, 0x00,
+			// AdditionalHeader
+			0x00, 0x00, 0x00,
+			// RequestHandle
+			0x10, 0x00, 0x00, 0x00,
+		},
+	},
+}
+
+func TestDecodeCancelRequest(t *testing.T) {
+	for _, c := range cancelRequestCases {
+		got, err := DecodeCancelRequest(c.serialized)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// need to clear Payload here.
+		got.Payload = nil
+
+		if diff := cmp.Diff(got, c.structured, decodeCmpOpt); diff != "" {
+			t.Errorf("%s failed\n%s", c.description, diff)
+		}
+	}
+}
+
+func TestSerializeCancelRequest(t *testing.T) {
+	for _, c := range cancelRequestCases {
+		got, err := c.structured.Serialize()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if diff := cmp.Diff(got, c.serialized); diff != "" {
+			t.Errorf("%s failed\n%s", c.description, diff)
+		}
+	}
+}
+
+func TestCancelRequestLen(t *testing.T) {
+	for _, c := range cancelRequestCases {
+		got := c.structured.Len()
+
+		if diff := cmp.Diff(got, len(c.serialized)); diff != "" {
+			t.Errorf("%s failed\n%s", c.description, diff)
+		}
+	}
+}
+
+func TestCancelRequestServiceType(t *testing.T) {
+	for _, c := range cancelRequestCases {
+		if c.structured.ServiceType() != ServiceTypeCancelRequest {
+			t.Errorf(
+				"ServiceType doesn't match. Want: %d, Got: %d",
+				ServiceTypeCancelRequest,
+				c.structured.ServiceType(),
+			)
+		}
+	}
+}
@@ -0,0 +1,134 @@
+// Copyright 2018 gopcua authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package services
+
+import (
+	"encoding/binary"
+	"time"
+
+	"github.com/wmnsk/gopcua/datatypes"
+)
+
+// CancelResponse represents the response to a CancelRequest.
+//
+// Specification: Part 4, 5.6.5.2
+type CancelResponse struct {
+	TypeID *datatypes.ExpandedNodeID
+	*ResponseHeader
+
+	// Number of cancelled requests.
+	CancelCount uint32
+}
+
+// NewCancelResponse creates a new CancelResponse.
+func NewCancelResponse(ts time.Time, handle, code uint32, diag *DiagnosticInfo, strs []string, count uint32) *CancelResponse {
+	return &CancelResponse{
+		TypeID: datatypes.NewExpandedNodeID(
+			false, false,
+			datatypes.NewFourByteNodeID(
+				0, ServiceTypeCancelResponse,
+			),
+			"", 0,
+		),
+		ResponseHeader: NewResponseHeader(
+			ts,
+			handle,
+			code,
+			diag,
+			strs,
+			NewAdditionalHeader(
+				datatypes.NewExpandedNodeID(
+					false, false,
+					datatypes.NewTwoByteNodeID(0),
+					"", 0,
+				),
+				0x00,
+			),
+			nil,
+		),
+		CancelCount: count,
+	}
+}
+
+// DecodeCancelResponse decodes given bytes into CancelResponse.
+func DecodeCancelResponse(b []byte) (*CancelResponse, error) {
+	c := &CancelResponse{}
+	if err := c.DecodeFromBytes(b); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// DecodeFromBytes decodes given bytes into CancelResponse.
+func (c *CancelResponse) DecodeFromBytes(b []byte) error {
+	offset := 0
+	c.TypeID = &datatypes.ExpandedNodeID{}
+	if err := c.TypeID.DecodeFromBytes(b[offset:]); err != nil {
+		return err
+	}
+	offset += c.TypeID.Len()
+
+	c.ResponseHeader = &ResponseHeader{}
+	if err := c.ResponseHeader.DecodeFromBytes(b[offset:]); err != nil {
+		return err
+	}
+	offset += c.ResponseHeader.Len() - len(c.ResponseHeader.Payload)
+
+	c.CancelCount = binary.LittleEndian.Uint32(b[offset : offset+4])
+
+	return nil
+}
+
+// Serialize serializes CancelResponse into bytes.
+func (c *CancelResponse) Serialize() ([]byte, error) {
+	b := make([]byte, c.Len())
+	if err := c.SerializeTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// SerializeTo serializes CancelResponse into bytes.
+func (c *CancelResponse) SerializeTo(b []byte) error {
+	offset := 0
+	if c.TypeID != nil {
+		if err := c.TypeID.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += c.TypeID.Len()
+	}
+
+	if c.ResponseHeader != nil {
+		if err := c.ResponseHeader.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += c.ResponseHeader.Len() - len(c.Payload)
+	}
+
+	binary.LittleEndian.PutUint32(b[offset:offset+4], c.CancelCount)
+
+	return nil
+}
+
+// Len returns the actual length of CancelResponse in int.
+func (c *CancelResponse) Len() int {
+	// CancelCount
+	l := 4
+	if c.TypeID != nil {
+		l += c.TypeID.Len()
+	}
+	if c.ResponseHeader != nil {
+		l += (c.ResponseHeader.Len() - len(c.Payload))
+	}
+
+	return l
+}
+
+// ServiceType returns type of Service in uint16.
+func (c *CancelResponse) ServiceType() uint16 {
+	return ServiceTypeCancelResponse
+}
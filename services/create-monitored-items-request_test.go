@@ -0,0 +1,132 @@
+// Copyright 2018 gopcua authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/wmnsk/gopcua/datatypes"
+)
+
+var createMonitoredItemsRequestCases = []struct {
+	description string
+	structured  *CreateMonitoredItemsRequest
+	serialized  []byte
+}{
+	{
+		"normal",
+		NewCreateMonitoredItemsRequest(
+			time.Date(2018, time.August, 10, 23, 0, 0, 0, time.UTC),
+			datatypes.NewTwoByteNodeID(0), 1, 0, 0, "",
+			1, TimestampsToReturnBoth,
+			NewMonitoredItemCreateRequest(
+				datatypes.NewReadValueID(
+					datatypes.NewFourByteNodeID(0, 2256),
+					datatypes.IntegerIDValue,
+					"", 0, "",
+				),
+				MonitoringModeReporting,
+				NewMonitoringParameters(1, 1000, datatypes.NewNullExtensionObject(), 1, true),
+			),
+		),
+		[]byte{ // CreateMonitoredItemsRequest
+			// TypeID
+			0x01, 0x00, 0xef, 0x02,
+			// RequestHeader
+			// AuthenticationToken
+			0x00, 0x00,
+			// Timestamp
+			0x00, 0x98, 0x67, 0xdd, 0xfd, 0x30, 0xd4, 0x01,
+			// RequestHandle
+			0x01, 0x00, 0x00, 0x00,
+			// ReturnDiagnostics
+			0x00, 0x00, 0x00, 0x00,
+			// AuditEntryID
+			0xff, 0xff, 0xff, 0xff,
+			// TimeoutHint
+			0x00, 0x00, 0x00, 0x00,
+			// AdditionalHeader
+			0x00, 0x00, 0x00,
+			// SubscriptionID
+			0x01, 0x00, 0x00, 0x00,
+			// TimestampsToReturn
+			0x02, 0x00, 0x00, 0x00,
+			// ItemsToCreate
+			// ArraySize
+			0x01, 0x00, 0x00, 0x00,
+			// ItemToMonitor
+			0x01, 0x00, 0xd0, 0x08, 0x0d, 0x00, 0x00, 0x00,
+			0xff, 0xff, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff,
+			0xff, 0xff,
+			// MonitoringMode
+			0x02, 0x00, 0x00, 0x00,
+			// RequestedParameters
+			// ClientHandle
+			0x01, 0x00, 0x00, 0x00,
+			// SamplingInterval
+			0x00, 0x00, 0x00, 0x00, 0x00, 0x40, 0x8f, 0x40,
+			// Filter
+			0x00, 0x00, 0x00,
+			// QueueSize
+			0x01, 0x00, 0x00, 0x00,
+			// DiscardOldest
+			0x01,
+		},
+	},
+}
+
+func TestDecodeCreateMonitoredItemsRequest(t *testing.T) {
+	for _, c := range createMonitoredItemsRequestCases {
+		got, err := DecodeCreateMonitoredItemsRequest(c.serialized)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// need to clear Payload here.
+		got.Payload = nil
+
+		if diff := cmp.Diff(got, c.structured, decodeCmpOpt); diff != "" {
+			t.Errorf("%s failed\n%s", c.description, diff)
+		}
+	}
+}
+
+func TestSerializeCreateMonitoredItemsRequest(t *testing.T) {
+	for _, c := range createMonitoredItemsRequestCases {
+		got, err := c.structured.Serialize()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if diff := cmp.Diff(got, c.serialized); diff != "" {
+			t.Errorf("%s failed\n%s", c.description, diff)
+		}
+	}
+}
+
+func TestCreateMonitoredItemsRequestLen(t *testing.T) {
+	for _, c := range createMonitoredItemsRequestCases {
+		got := c.structured.Len()
+
+		if diff := cmp.Diff(got, len(c.serialized)); diff != "" {
+			t.Errorf("%s failed\n%s", c.description, diff)
+		}
+	}
+}
+
+func TestCreateMonitoredItemsRequestServiceType(t *testing.T) {
+	for _, c := range createMonitoredItemsRequestCases {
+		if c.structured.ServiceType() != ServiceTypeCreateMonitoredItemsRequest {
+			t.Errorf(
+				"ServiceType doesn't match. Want: %d, Got: %d",
+				ServiceTypeCreateMonitoredItemsRequest,
+				c.structured.ServiceType(),
+			)
+		}
+	}
+}
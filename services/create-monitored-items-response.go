@@ -0,0 +1,153 @@
+// Copyright 2018 gopcua authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package services
+
+import (
+	"time"
+
+	"github.com/wmnsk/gopcua/datatypes"
+)
+
+// CreateMonitoredItemsResponse represents the response to a CreateMonitoredItemsRequest.
+// Results are in the same order as the items in the request.
+//
+// Specification: Part 4, 5.12.2.2
+type CreateMonitoredItemsResponse struct {
+	TypeID *datatypes.ExpandedNodeID
+	*ResponseHeader
+	Results         *MonitoredItemCreateResultArray
+	DiagnosticInfos *DiagnosticInfoArray
+}
+
+// NewCreateMonitoredItemsResponse creates a new CreateMonitoredItemsResponse.
+func NewCreateMonitoredItemsResponse(ts time.Time, handle, code uint32, diag *DiagnosticInfo, strs []string, results []*MonitoredItemCreateResult, diags []*DiagnosticInfo) *CreateMonitoredItemsResponse {
+	return &CreateMonitoredItemsResponse{
+		TypeID: datatypes.NewExpandedNodeID(
+			false, false,
+			datatypes.NewFourByteNodeID(
+				0, ServiceTypeCreateMonitoredItemsResponse,
+			),
+			"", 0,
+		),
+		ResponseHeader: NewResponseHeader(
+			ts,
+			handle,
+			code,
+			diag,
+			strs,
+			NewAdditionalHeader(
+				datatypes.NewExpandedNodeID(
+					false, false,
+					datatypes.NewTwoByteNodeID(0),
+					"", 0,
+				),
+				0x00,
+			),
+			nil,
+		),
+		Results:         NewMonitoredItemCreateResultArray(results),
+		DiagnosticInfos: NewDiagnosticInfoArray(diags),
+	}
+}
+
+// DecodeCreateMonitoredItemsResponse decodes given bytes into CreateMonitoredItemsResponse.
+func DecodeCreateMonitoredItemsResponse(b []byte) (*CreateMonitoredItemsResponse, error) {
+	c := &CreateMonitoredItemsResponse{}
+	if err := c.DecodeFromBytes(b); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// DecodeFromBytes decodes given bytes into CreateMonitoredItemsResponse.
+func (c *CreateMonitoredItemsResponse) DecodeFromBytes(b []byte) error {
+	offset := 0
+	c.TypeID = &datatypes.ExpandedNodeID{}
+	if err := c.TypeID.DecodeFromBytes(b[offset:]); err != nil {
+		return err
+	}
+	offset += c.TypeID.Len()
+
+	c.ResponseHeader = &ResponseHeader{}
+	if err := c.ResponseHeader.DecodeFromBytes(b[offset:]); err != nil {
+		return err
+	}
+	offset += c.ResponseHeader.Len() - len(c.ResponseHeader.Payload)
+
+	c.Results = &MonitoredItemCreateResultArray{}
+	if err := c.Results.DecodeFromBytes(b[offset:]); err != nil {
+		return err
+	}
+	offset += c.Results.Len()
+
+	c.DiagnosticInfos = &DiagnosticInfoArray{}
+	return c.DiagnosticInfos.DecodeFromBytes(b[offset:])
+}
+
+// Serialize serializes CreateMonitoredItemsResponse into bytes.
+func (c *CreateMonitoredItemsResponse) Serialize() ([]byte, error) {
+	b := make([]byte, c.Len())
+	if err := c.SerializeTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// SerializeTo serializes CreateMonitoredItemsResponse into bytes.
+func (c *CreateMonitoredItemsResponse) SerializeTo(b []byte) error {
+	offset := 0
+	if c.TypeID != nil {
+		if err := c.TypeID.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += c.TypeID.Len()
+	}
+
+	if c.ResponseHeader != nil {
+		if err := c.ResponseHeader.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += c.ResponseHeader.Len() - len(c.Payload)
+	}
+
+	if c.Results != nil {
+		if err := c.Results.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += c.Results.Len()
+	}
+
+	if c.DiagnosticInfos != nil {
+		return c.DiagnosticInfos.SerializeTo(b[offset:])
+	}
+
+	return nil
+}
+
+// Len returns the actual length of CreateMonitoredItemsResponse in int.
+func (c *CreateMonitoredItemsResponse) Len() int {
+	l := 0
+	if c.TypeID != nil {
+		l += c.TypeID.Len()
+	}
+	if c.ResponseHeader != nil {
+		l += (c.ResponseHeader.Len() - len(c.Payload))
+	}
+	if c.Results != nil {
+		l += c.Results.Len()
+	}
+	if c.DiagnosticInfos != nil {
+		l += c.DiagnosticInfos.Len()
+	}
+
+	return l
+}
+
+// ServiceType returns type of Service in uint16.
+func (c *CreateMonitoredItemsResponse) ServiceType() uint16 {
+	return ServiceTypeCreateMonitoredItemsResponse
+}
@@ -0,0 +1,126 @@
+// Copyright 2018 gopcua authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/wmnsk/gopcua/datatypes"
+)
+
+var createMonitoredItemsResponseCases = []struct {
+	description string
+	structured  *CreateMonitoredItemsResponse
+	serialized  []byte
+}{
+	{
+		"normal",
+		NewCreateMonitoredItemsResponse(
+			time.Date(2018, time.August, 10, 23, 0, 0, 0, time.UTC),
+			1, 0, nil, nil,
+			[]*MonitoredItemCreateResult{
+				NewMonitoredItemCreateResult(0, 1, 250, 1, datatypes.NewNullExtensionObject()),
+				NewMonitoredItemCreateResult(0x80340000, 0, 0, 0, datatypes.NewNullExtensionObject()),
+			},
+			nil,
+		),
+		[]byte{ // CreateMonitoredItemsResponse
+			// TypeID
+			0x01, 0x00, 0xf2, 0x02,
+			// ResponseHeader
+			// Timestamp
+			0x00, 0x98, 0x67, 0xdd, 0xfd, 0x30, 0xd4, 0x01,
+			// RequestHandle
+			0x01, 0x00, 0x00, 0x00,
+			// ServiceResult
+			0x00, 0x00, 0x00, 0x00,
+			// ServiceDiagnostics
+			0x00,
+			// StringTable
+			0x00, 0x00, 0x00, 0x00,
+			// AdditionalHeader
+			0x00, 0x00, 0x00,
+			// Results
+			// ArraySize
+			0x02, 0x00, 0x00, 0x00,
+			// StatusCode
+			0x00, 0x00, 0x00, 0x00,
+			// MonitoredItemID
+			0x01, 0x00, 0x00, 0x00,
+			// RevisedSamplingInterval
+			0x00, 0x00, 0x00, 0x00, 0x00, 0x40, 0x6f, 0x40,
+			// RevisedQueueSize
+			0x01, 0x00, 0x00, 0x00,
+			// FilterResult
+			0x00, 0x00, 0x00,
+			// StatusCode: BadNodeIdUnknown
+			0x00, 0x00, 0x34, 0x80,
+			// MonitoredItemID
+			0x00, 0x00, 0x00, 0x00,
+			// RevisedSamplingInterval
+			0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			// RevisedQueueSize
+			0x00, 0x00, 0x00, 0x00,
+			// FilterResult
+			0x00, 0x00, 0x00,
+			// DiagnosticInfos
+			0x00, 0x00, 0x00, 0x00,
+		},
+	},
+}
+
+func TestDecodeCreateMonitoredItemsResponse(t *testing.T) {
+	for _, c := range createMonitoredItemsResponseCases {
+		got, err := DecodeCreateMonitoredItemsResponse(c.serialized)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// need to clear Payload here.
+		got.Payload = nil
+
+		if diff := cmp.Diff(got, c.structured, decodeCmpOpt); diff != "" {
+			t.Errorf("%s failed\n%s", c.description, diff)
+		}
+	}
+}
+
+func TestSerializeCreateMonitoredItemsResponse(t *testing.T) {
+	for _, c := range createMonitoredItemsResponseCases {
+		got, err := c.structured.Serialize()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if diff := cmp.Diff(got, c.serialized); diff != "" {
+			t.Errorf("%s failed\n%s", c.description, diff)
+		}
+	}
+}
+
+func TestCreateMonitoredItemsResponseLen(t *testing.T) {
+	for _, c := range createMonitoredItemsResponseCases {
+		got := c.structured.Len()
+
+		if diff := cmp.Diff(got, len(c.serialized)); diff != "" {
+			t.Errorf("%s failed\n%s", c.description, diff)
+		}
+	}
+}
+
+func TestCreateMonitoredItemsResponseServiceType(t *testing.T) {
+	for _, c := range createMonitoredItemsResponseCases {
+		if c.structured.ServiceType() != ServiceTypeCreateMonitoredItemsResponse {
+			t.Errorf(
+				"ServiceType doesn't match. Want: %d, Got: %d",
+				ServiceTypeCreateMonitoredItemsResponse,
+				c.structured.ServiceType(),
+			)
+		}
+	}
+}
@@ -7,6 +7,7 @@ package services
 import (
 	"encoding/binary"
 	"fmt"
+	"math"
 	"time"
 
 	"github.com/wmnsk/gopcua/datatypes"
@@ -25,12 +26,27 @@ type CreateSessionRequest struct {
 	SessionName             *datatypes.String
 	ClientNonce             *datatypes.ByteString
 	ClientCertificate       *datatypes.ByteString
-	RequestedSessionTimeout uint64
+	RequestedSessionTimeout float64
 	MaxResponseMessageSize  uint32
 }
 
 // NewCreateSessionRequest creates a new NewCreateSessionRequest with the given parameters.
-func NewCreateSessionRequest(time time.Time, appURI, prodURI, appName string, appType uint32, serverURI, endpoint, sessionName string, nonce, cert []byte, timeout uint64, maxRespSize uint32) *CreateSessionRequest {
+//
+// GatewayServerURI, DiscoveryProfileURI and DiscoveryURLs in ClientDescription are left empty.
+// Use NewCreateSessionRequestWithDescription to specify them.
+func NewCreateSessionRequest(time time.Time, appURI, prodURI, appName string, appType uint32, serverURI, endpoint, sessionName string, nonce, cert []byte, timeout float64, maxRespSize uint32) *CreateSessionRequest {
+	return NewCreateSessionRequestWithDescription(
+		time,
+		NewApplicationDescription(
+			appURI, prodURI, appName, appType, "", "", nil,
+		),
+		serverURI, endpoint, sessionName, nonce, cert, timeout, maxRespSize,
+	)
+}
+
+// NewCreateSessionRequestWithDescription creates a new NewCreateSessionRequest with
+// the given ApplicationDescription as ClientDescription.
+func NewCreateSessionRequestWithDescription(time time.Time, desc *ApplicationDescription, serverURI, endpoint, sessionName string, nonce, cert []byte, timeout float64, maxRespSize uint32) *CreateSessionRequest {
 	return &CreateSessionRequest{
 		TypeID: datatypes.NewExpandedNodeID(
 			false, false,
@@ -43,9 +59,7 @@ func NewCreateSessionRequest(time time.Time, appURI, prodURI, appName string, ap
 			NewNullAdditionalHeader(),
 			nil,
 		),
-		ClientDescription: NewApplicationDescription(
-			appURI, prodURI, appName, appType, "", "", nil,
-		),
+		ClientDescription:       desc,
 		ServerURI:               datatypes.NewString(serverURI),
 		EndpointURL:             datatypes.NewString(endpoint),
 		SessionName:             datatypes.NewString(sessionName),
@@ -121,7 +135,7 @@ func (c *CreateSessionRequest) DecodeFromBytes(b []byte) error {
 	}
 	offset += c.ClientCertificate.Len()
 
-	c.RequestedSessionTimeout = binary.LittleEndian.Uint64(b[offset : offset+8])
+	c.RequestedSessionTimeout = math.Float64frombits(binary.LittleEndian.Uint64(b[offset : offset+8]))
 	offset += 8
 
 	c.MaxResponseMessageSize = binary.LittleEndian.Uint32(b[offset : offset+4])
@@ -198,7 +212,7 @@ func (c *CreateSessionRequest) SerializeTo(b []byte) error {
 		offset += c.ClientCertificate.Len()
 	}
 
-	binary.LittleEndian.PutUint64(b[offset:offset+8], c.RequestedSessionTimeout)
+	binary.LittleEndian.PutUint64(b[offset:offset+8], math.Float64bits(c.RequestedSessionTimeout))
 	offset += 8
 
 	binary.LittleEndian.PutUint32(b[offset:offset+4], c.MaxResponseMessageSize)
@@ -239,7 +253,7 @@ func (c *CreateSessionRequest) Len() int {
 
 // String returns CreateSessionRequest in string.
 func (c *CreateSessionRequest) String() string {
-	return fmt.Sprintf("%v, %v, %v, %v, %v, %v, %v, %d, %d",
+	return fmt.Sprintf("%v, %v, %v, %v, %v, %v, %v, %v, %d",
 		c.TypeID,
 		c.RequestHeader,
 		c.ClientDescription,
@@ -0,0 +1,281 @@
+// Copyright 2018 gopcua authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+var createSessionRequestCases = []struct {
+	description string
+	structured  *CreateSessionRequest
+	serialized  []byte
+}{
+	{
+		"no-nonce-no-cert",
+		NewCreateSessionRequest(
+			time.Date(2018, time.August, 10, 23, 0, 0, 0, time.UTC),
+			"app-uri", "prod-uri", "app-name", AppTypeClient,
+			"server-uri", "endpoint-url", "session-name",
+			nil, nil, 6000000, 65534,
+		),
+		[]byte{ // CreateSessionRequest
+			// TypeID
+			0x01, 0x00, 0xcd, 0x01,
+			// RequestHeader
+			// AuthenticationToken
+			0x00, 0x00,
+			// Timestamp
+			0x00, 0x98, 0x67, 0xdd, 0xfd, 0x30, 0xd4, 0x01,
+			// RequestHandle
+			0x01, 0x00, 0x00, 0x00,
+			// ReturnDiagnostics
+			0x00, 0x00, 0x00, 0x00,
+			// AuditEntryID
+			0xff, 0xff, 0xff, 0xff,
+			// TimeoutHint
+			0x00, 0x00, 0x00, 0x00,
+			// AdditionalHeader
+			0x00, 0x00, 0x00,
+			// ClientDescription: ApplicationDescription
+			// ApplicationURI
+			0x07, 0x00, 0x00, 0x00, 0x61, 0x70, 0x70, 0x2d,
+			0x75, 0x72, 0x69,
+			// ProductURI
+			0x08, 0x00, 0x00, 0x00, 0x70, 0x72, 0x6f, 0x64,
+			0x2d, 0x75, 0x72, 0x69,
+			// ApplicationName
+			0x02, 0x08, 0x00, 0x00, 0x00, 0x61, 0x70, 0x70,
+			0x2d, 0x6e, 0x61, 0x6d, 0x65,
+			// ApplicationType
+			0x01, 0x00, 0x00, 0x00,
+			// GatewayServerURI
+			0xff, 0xff, 0xff, 0xff,
+			// DiscoveryProfileURI
+			0xff, 0xff, 0xff, 0xff,
+			// DiscoveryURLs
+			0x00, 0x00, 0x00, 0x00,
+			// ServerURI
+			0x0a, 0x00, 0x00, 0x00, 0x73, 0x65, 0x72, 0x76,
+			0x65, 0x72, 0x2d, 0x75, 0x72, 0x69,
+			// EndpointURL
+			0x0c, 0x00, 0x00, 0x00, 0x65, 0x6e, 0x64, 0x70,
+			0x6f, 0x69, 0x6e, 0x74, 0x2d, 0x75, 0x72, 0x6c,
+			// SessionName
+			0x0c, 0x00, 0x00, 0x00, 0x73, 0x65, 0x73, 0x73,
+			0x69, 0x6f, 0x6e, 0x2d, 0x6e, 0x61, 0x6d, 0x65,
+			// ClientNonce
+			0xff, 0xff, 0xff, 0xff,
+			// ClientCertificate
+			0xff, 0xff, 0xff, 0xff,
+			// RequestedSessionTimeout
+			0x00, 0x00, 0x00, 0x00, 0x60, 0xe3, 0x56, 0x41,
+			// MaxResponseMessageSize
+			0xfe, 0xff, 0x00, 0x00,
+		},
+	},
+	{
+		"with-nonce-and-cert",
+		NewCreateSessionRequest(
+			time.Date(2018, time.August, 10, 23, 0, 0, 0, time.UTC),
+			"app-uri", "prod-uri", "app-name", AppTypeClient,
+			"server-uri", "endpoint-url", "session-name",
+			[]byte{0xde, 0xad, 0xbe, 0xef}, []byte{0xca, 0xfe}, 6000000, 65534,
+		),
+		[]byte{ // CreateSessionRequest
+			// TypeID
+			0x01, 0x00, 0xcd, 0x01,
+			// RequestHeader
+			// AuthenticationToken
+			0x00, 0x00,
+			// Timestamp
+			0x00, 0x98, 0x67, 0xdd, 0xfd, 0x30, 0xd4, 0x01,
+			// RequestHandle
+			0x01, 0x00, 0x00, 0x00,
+			// ReturnDiagnostics
+			0x00, 0x00, 0x00, 0x00,
+			// AuditEntryID
+			0xff, 0xff, 0xff, 0xff,
+			// TimeoutHint
+			0x00, 0x00, 0x00, 0x00,
+			// AdditionalHeader
+			0x00, 0x00, 0x00,
+			// ClientDescription: ApplicationDescription
+			// ApplicationURI
+			0x07, 0x00, 0x00, 0x00, 0x61, 0x70, 0x70, 0x2d,
+			0x75, 0x72, 0x69,
+			// ProductURI
+			0x08, 0x00, 0x00, 0x00, 0x70, 0x72, 0x6f, 0x64,
+			0x2d, 0x75, 0x72, 0x69,
+			// ApplicationName
+			0x02, 0x08, 0x00, 0x00, 0x00, 0x61, 0x70, 0x70,
+			0x2d, 0x6e, 0x61, 0x6d, 0x65,
+			// ApplicationType
+			0x01, 0x00, 0x00, 0x00,
+			// GatewayServerURI
+			0xff, 0xff, 0xff, 0xff,
+			// DiscoveryProfileURI
+			0xff, 0xff, 0xff, 0xff,
+			// DiscoveryURLs
+			0x00, 0x00, 0x00, 0x00,
+			// ServerURI
+			0x0a, 0x00, 0x00, 0x00, 0x73, 0x65, 0x72, 0x76,
+			0x65, 0x72, 0x2d, 0x75, 0x72, 0x69,
+			// EndpointURL
+			0x0c, 0x00, 0x00, 0x00, 0x65, 0x6e, 0x64, 0x70,
+			0x6f, 0x69, 0x6e, 0x74, 0x2d, 0x75, 0x72, 0x6c,
+			// SessionName
+			0x0c, 0x00, 0x00, 0x00, 0x73, 0x65, 0x73, 0x73,
+			0x69, 0x6f, 0x6e, 0x2d, 0x6e, 0x61, 0x6d, 0x65,
+			// ClientNonce
+			0x04, 0x00, 0x00, 0x00, 0xde, 0xad, 0xbe, 0xef,
+			// ClientCertificate
+			0x02, 0x00, 0x00, 0x00, 0xca, 0xfe,
+			// RequestedSessionTimeout
+			0x00, 0x00, 0x00, 0x00, 0x60, 0xe3, 0x56, 0x41,
+			// MaxResponseMessageSize
+			0xfe, 0xff, 0x00, 0x00,
+		},
+	},
+	{
+		"full-client-description",
+		NewCreateSessionRequestWithDescription(
+			time.Date(2018, time.August, 10, 23, 0, 0, 0, time.UTC),
+			NewApplicationDescription(
+				"app-uri", "prod-uri", "app-name", AppTypeClient,
+				"opc.tcp://gateway:4840",
+				"http://opcfoundation.org/UA-Profile/Discovery/Global",
+				[]string{"opc.tcp://client:4841", "opc.tcp://client:4842"},
+			),
+			"server-uri", "endpoint-url", "session-name",
+			nil, nil, 6000000, 65534,
+		),
+		[]byte{ // CreateSessionRequest
+			// TypeID
+			0x01, 0x00, 0xcd, 0x01,
+			// RequestHeader
+			// AuthenticationToken
+			0x00, 0x00,
+			// Timestamp
+			0x00, 0x98, 0x67, 0xdd, 0xfd, 0x30, 0xd4, 0x01,
+			// RequestHandle
+			0x01, 0x00, 0x00, 0x00,
+			// ReturnDiagnostics
+			0x00, 0x00, 0x00, 0x00,
+			// AuditEntryID
+			0xff, 0xff, 0xff, 0xff,
+			// TimeoutHint
+			0x00, 0x00, 0x00, 0x00,
+			// AdditionalHeader
+			0x00, 0x00, 0x00,
+			// ClientDescription: ApplicationDescription
+			// ApplicationURI
+			0x07, 0x00, 0x00, 0x00, 0x61, 0x70, 0x70, 0x2d,
+			0x75, 0x72, 0x69,
+			// ProductURI
+			0x08, 0x00, 0x00, 0x00, 0x70, 0x72, 0x6f, 0x64,
+			0x2d, 0x75, 0x72, 0x69,
+			// ApplicationName
+			0x02, 0x08, 0x00, 0x00, 0x00, 0x61, 0x70, 0x70,
+			0x2d, 0x6e, 0x61, 0x6d, 0x65,
+			// ApplicationType
+			0x01, 0x00, 0x00, 0x00,
+			// GatewayServerURI
+			0x16, 0x00, 0x00, 0x00, 0x6f, 0x70, 0x63, 0x2e,
+			0x74, 0x63, 0x70, 0x3a, 0x2f, 0x2f, 0x67, 0x61,
+			0x74, 0x65, 0x77, 0x61, 0x79, 0x3a, 0x34, 0x38,
+			0x34, 0x30,
+			// DiscoveryProfileURI
+			0x34, 0x00, 0x00, 0x00, 0x68, 0x74, 0x74, 0x70,
+			0x3a, 0x2f, 0x2f, 0x6f, 0x70, 0x63, 0x66, 0x6f,
+			0x75, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+			0x2e, 0x6f, 0x72, 0x67, 0x2f, 0x55, 0x41, 0x2d,
+			0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2f,
+			0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72,
+			0x79, 0x2f, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c,
+			// DiscoveryURLs
+			0x02, 0x00, 0x00, 0x00,
+			0x15, 0x00, 0x00, 0x00, 0x6f, 0x70, 0x63, 0x2e,
+			0x74, 0x63, 0x70, 0x3a, 0x2f, 0x2f, 0x63, 0x6c,
+			0x69, 0x65, 0x6e, 0x74, 0x3a, 0x34, 0x38, 0x34,
+			0x31,
+			0x15, 0x00, 0x00, 0x00, 0x6f, 0x70, 0x63, 0x2e,
+			0x74, 0x63, 0x70, 0x3a, 0x2f, 0x2f, 0x63, 0x6c,
+			0x69, 0x65, 0x6e, 0x74, 0x3a, 0x34, 0x38, 0x34,
+			0x32,
+			// ServerURI
+			0x0a, 0x00, 0x00, 0x00, 0x73, 0x65, 0x72, 0x76,
+			0x65, 0x72, 0x2d, 0x75, 0x72, 0x69,
+			// EndpointURL
+			0x0c, 0x00, 0x00, 0x00, 0x65, 0x6e, 0x64, 0x70,
+			0x6f, 0x69, 0x6e, 0x74, 0x2d, 0x75, 0x72, 0x6c,
+			// SessionName
+			0x0c, 0x00, 0x00, 0x00, 0x73, 0x65, 0x73, 0x73,
+			0x69, 0x6f, 0x6e, 0x2d, 0x6e, 0x61, 0x6d, 0x65,
+			// ClientNonce
+			0xff, 0xff, 0xff, 0xff,
+			// ClientCertificate
+			0xff, 0xff, 0xff, 0xff,
+			// RequestedSessionTimeout
+			0x00, 0x00, 0x00, 0x00, 0x60, 0xe3, 0x56, 0x41,
+			// MaxResponseMessageSize
+			0xfe, 0xff, 0x00, 0x00,
+		},
+	},
+}
+
+func TestDecodeCreateSessionRequest(t *testing.T) {
+	for _, c := range createSessionRequestCases {
+		got, err := DecodeCreateSessionRequest(c.serialized)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// need to clear Payload here.
+		got.Payload = nil
+
+		if diff := cmp.Diff(got, c.structured, decodeCmpOpt); diff != "" {
+			t.Errorf("%s failed\n%s", c.description, diff)
+		}
+	}
+}
+
+func TestSerializeCreateSessionRequest(t *testing.T) {
+	for _, c := range createSessionRequestCases {
+		got, err := c.structured.Serialize()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if diff := cmp.Diff(got, c.serialized); diff != "" {
+			t.Errorf("%s failed\n%s", c.description, diff)
+		}
+	}
+}
+
+func TestCreateSessionRequestLen(t *testing.T) {
+	for _, c := range createSessionRequestCases {
+		got := c.structured.Len()
+
+		if diff := cmp.Diff(got, len(c.serialized)); diff != "" {
+			t.Errorf("%s failed\n%s", c.description, diff)
+		}
+	}
+}
+
+func TestCreateSessionRequestServiceType(t *testing.T) {
+	for _, c := range createSessionRequestCases {
+		if c.structured.ServiceType() != ServiceTypeCreateSessionRequest {
+			t.Errorf(
+				"ServiceType doesn't match. Want: %d, Got: %d",
+				ServiceTypeCreateSessionRequest,
+				c.structured.ServiceType(),
+			)
+		}
+	}
+}
@@ -7,6 +7,7 @@ package services
 import (
 	"encoding/binary"
 	"fmt"
+	"math"
 	"time"
 
 	"github.com/wmnsk/gopcua/datatypes"
@@ -21,7 +22,7 @@ type CreateSessionResponse struct {
 	*ResponseHeader
 	SessionID                  datatypes.NodeID
 	AuthenticationToken        datatypes.NodeID
-	RevisedSessionTimeout      uint64
+	RevisedSessionTimeout      float64
 	ServerNonce                *datatypes.ByteString
 	ServerCertificate          *datatypes.ByteString
 	ServerEndpoints            *EndpointDescriptionArray
@@ -31,7 +32,7 @@ type CreateSessionResponse struct {
 }
 
 // NewCreateSessionResponse creates a new NewCreateSessionResponse with the given parameters.
-func NewCreateSessionResponse(time time.Time, result uint32, diag *DiagnosticInfo, sessionID uint32, authToken []byte, timeout uint64, nonce, cert []byte, alg string, sign []byte, maxRespSize uint32, endpoints ...*EndpointDescription) *CreateSessionResponse {
+func NewCreateSessionResponse(time time.Time, result uint32, diag *DiagnosticInfo, sessionID uint32, authToken []byte, timeout float64, nonce, cert []byte, alg string, sign []byte, maxRespSize uint32, endpoints ...*EndpointDescription) *CreateSessionResponse {
 	return &CreateSessionResponse{
 		TypeID: datatypes.NewExpandedNodeID(
 			false, false,
@@ -98,7 +99,7 @@ func (c *CreateSessionResponse) DecodeFromBytes(b []byte) error {
 	c.AuthenticationToken = authenticationToken
 	offset += c.AuthenticationToken.Len()
 
-	c.RevisedSessionTimeout = binary.LittleEndian.Uint64(b[offset : offset+8])
+	c.RevisedSessionTimeout = math.Float64frombits(binary.LittleEndian.Uint64(b[offset : offset+8]))
 	offset += 8
 
 	c.ServerNonce = &datatypes.ByteString{}
@@ -177,7 +178,7 @@ func (c *CreateSessionResponse) SerializeTo(b []byte) error {
 		offset += c.AuthenticationToken.Len()
 	}
 
-	binary.LittleEndian.PutUint64(b[offset:offset+8], c.RevisedSessionTimeout)
+	binary.LittleEndian.PutUint64(b[offset:offset+8], math.Float64bits(c.RevisedSessionTimeout))
 	offset += 8
 
 	if c.ServerNonce != nil {
@@ -256,7 +257,7 @@ func (c *CreateSessionResponse) Len() int {
 
 // String returns CreateSessionResponse in string.
 func (c *CreateSessionResponse) String() string {
-	return fmt.Sprintf("%v, %v, %v, %v, %d, %v, %v, %v, %v, %v, %d",
+	return fmt.Sprintf("%v, %v, %v, %v, %v, %v, %v, %v, %v, %v, %d",
 		c.TypeID,
 		c.ResponseHeader,
 		c.SessionID,
@@ -0,0 +1,164 @@
+// Copyright 2018 gopcua authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package services
+
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/wmnsk/gopcua/datatypes"
+	"github.com/wmnsk/gopcua/errors"
+	"github.com/wmnsk/gopcua/id"
+)
+
+func init() {
+	datatypes.RegisterExtensionObject(
+		datatypes.NewFourByteNodeID(0, id.DataChangeFilter_Encoding_DefaultBinary),
+		func() datatypes.Data { return &DataChangeFilter{} },
+	)
+}
+
+// DataChangeTrigger is an enumeration that specifies the conditions under which
+// a data change Notification should be reported.
+//
+// Specification: Part 4, 7.17.2
+type DataChangeTrigger uint32
+
+// DataChangeTrigger definitions.
+const (
+	// Report a Notification only if the StatusCode changes.
+	DataChangeTriggerStatus DataChangeTrigger = iota
+
+	// Report a Notification if either the StatusCode or the Value changes.
+	DataChangeTriggerStatusValue
+
+	// Report a Notification if either the StatusCode, the Value or the SourceTimestamp changes.
+	DataChangeTriggerStatusValueTimestamp
+)
+
+// DeadbandType is an enumeration that specifies the type of the deadband of DataChangeFilter.
+//
+// Specification: Part 4, 7.17.2
+type DeadbandType uint32
+
+// DeadbandType definitions.
+const (
+	// No deadband calculation should be applied.
+	DeadbandTypeNone DeadbandType = iota
+
+	// A Notification is generated if the absolute value of the difference between
+	// the last cached value and the current value is greater than DeadbandValue.
+	DeadbandTypeAbsolute
+
+	// A Notification is generated if the difference between the last cached value and
+	// the current value is greater than DeadbandValue percent of the EURange.
+	DeadbandTypePercent
+)
+
+// DataChangeFilter is used as the filter of MonitoringParameters to define the conditions
+// under which a data change Notification should be reported.
+//
+// It is sent in the body of an ExtensionObject, which is created by ExtensionObject method.
+// DeadbandTypePercent is only valid for the AnalogItems which have EURange Property,
+// but it is not checked in the package and the Server returns an error otherwise.
+//
+// Specification: Part 4, 7.17.2
+type DataChangeFilter struct {
+	Trigger      DataChangeTrigger
+	DeadbandType DeadbandType
+
+	// The absolute value for DeadbandTypeAbsolute, or the percentage
+	// of the EURange for DeadbandTypePercent, e.g. 10.0 for 10%.
+	DeadbandValue float64
+}
+
+// NewDataChangeFilter creates a new DataChangeFilter.
+func NewDataChangeFilter(trigger DataChangeTrigger, deadbandType DeadbandType, deadbandValue float64) *DataChangeFilter {
+	return &DataChangeFilter{
+		Trigger:       trigger,
+		DeadbandType:  deadbandType,
+		DeadbandValue: deadbandValue,
+	}
+}
+
+// DecodeDataChangeFilter decodes given bytes into DataChangeFilter.
+func DecodeDataChangeFilter(b []byte) (*DataChangeFilter, error) {
+	d := &DataChangeFilter{}
+	if err := d.DecodeFromBytes(b); err != nil {
+		return nil, err
+	}
+
+	return d, nil
+}
+
+// DecodeFromBytes decodes given bytes into DataChangeFilter.
+func (d *DataChangeFilter) DecodeFromBytes(b []byte) error {
+	if len(b) < 16 {
+		return errors.NewErrTooShortToDecode(d, "should be longer than 16 bytes")
+	}
+
+	offset := 0
+	d.Trigger = DataChangeTrigger(binary.LittleEndian.Uint32(b[offset : offset+4]))
+	offset += 4
+
+	d.DeadbandType = DeadbandType(binary.LittleEndian.Uint32(b[offset : offset+4]))
+	offset += 4
+
+	d.DeadbandValue = math.Float64frombits(binary.LittleEndian.Uint64(b[offset : offset+8]))
+
+	return nil
+}
+
+// Serialize serializes DataChangeFilter into bytes.
+func (d *DataChangeFilter) Serialize() ([]byte, error) {
+	b := make([]byte, d.Len())
+	if err := d.SerializeTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// SerializeTo serializes DataChangeFilter into bytes.
+func (d *DataChangeFilter) SerializeTo(b []byte) error {
+	offset := 0
+	binary.LittleEndian.PutUint32(b[offset:offset+4], uint32(d.Trigger))
+	offset += 4
+
+	binary.LittleEndian.PutUint32(b[offset:offset+4], uint32(d.DeadbandType))
+	offset += 4
+
+	binary.LittleEndian.PutUint64(b[offset:offset+8], math.Float64bits(d.DeadbandValue))
+
+	return nil
+}
+
+// Len returns the actual length of DataChangeFilter in int.
+func (d *DataChangeFilter) Len() int {
+	// Trigger + DeadbandType + DeadbandValue
+	return 16
+}
+
+// DataType returns type of Data.
+func (d *DataChangeFilter) DataType() uint16 {
+	return id.Structure
+}
+
+// ExtensionObject returns DataChangeFilter in ExtensionObject, which can be used as
+// the Filter in MonitoringParameters.
+func (d *DataChangeFilter) ExtensionObject() *datatypes.ExtensionObject {
+	e := &datatypes.ExtensionObject{
+		TypeID: datatypes.NewExpandedNodeID(
+			false, false,
+			datatypes.NewFourByteNodeID(0, id.DataChangeFilter_Encoding_DefaultBinary),
+			"", 0,
+		),
+		EncodingMask: 0x01,
+		Value:        d,
+	}
+	e.SetLength()
+
+	return e
+}
@@ -0,0 +1,94 @@
+// Copyright 2018 gopcua authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package services
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/wmnsk/gopcua/datatypes"
+)
+
+var dataChangeFilterParamsBytes = []byte{
+	// ClientHandle
+	0x01, 0x00, 0x00, 0x00,
+	// SamplingInterval
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x40, 0x8f, 0x40,
+	// Filter
+	// TypeID
+	0x01, 0x00, 0xd4, 0x02,
+	// EncodingMask
+	0x01,
+	// Length
+	0x10, 0x00, 0x00, 0x00,
+	// Trigger
+	0x01, 0x00, 0x00, 0x00,
+	// DeadbandType
+	0x01, 0x00, 0x00, 0x00,
+	// DeadbandValue
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x04, 0x40,
+	// QueueSize
+	0x01, 0x00, 0x00, 0x00,
+	// DiscardOldest
+	0x01,
+}
+
+func TestDataChangeFilter(t *testing.T) {
+	d := NewDataChangeFilter(DataChangeTriggerStatusValue, DeadbandTypeAbsolute, 2.5)
+	p := NewMonitoringParameters(1, 1000, d.ExtensionObject(), 1, true)
+
+	t.Run("serialize", func(t *testing.T) {
+		b, err := p.Serialize()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(b, dataChangeFilterParamsBytes); diff != "" {
+			t.Error(diff)
+		}
+	})
+	t.Run("decode", func(t *testing.T) {
+		got, err := DecodeMonitoringParameters(dataChangeFilterParamsBytes)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, p, decodeCmpOpt); diff != "" {
+			t.Error(diff)
+		}
+		if diff := cmp.Diff(got.Filter.Value, d); diff != "" {
+			t.Error(diff)
+		}
+	})
+	t.Run("extension-object", func(t *testing.T) {
+		// the Filter without ClientHandle, SamplingInterval, QueueSize and DiscardOldest.
+		filterBytes := dataChangeFilterParamsBytes[12:37]
+
+		b, err := d.ExtensionObject().Serialize()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(b, filterBytes); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err := datatypes.DecodeExtensionObject(filterBytes)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got.Value, d); diff != "" {
+			t.Error(diff)
+		}
+	})
+	t.Run("len", func(t *testing.T) {
+		if p.Len() != len(dataChangeFilterParamsBytes) {
+			t.Errorf("Len doesn't match. Want: %d, Got: %d", len(dataChangeFilterParamsBytes), p.Len())
+		}
+	})
+	t.Run("too-short", func(t *testing.T) {
+		if _, err := DecodeDataChangeFilter([]byte{0x01, 0x00, 0x00, 0x00}); err == nil {
+			t.Error("expected error")
+		}
+	})
+}
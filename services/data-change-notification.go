@@ -0,0 +1,308 @@
+// Copyright 2018 gopcua authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package services
+
+import (
+	"encoding/binary"
+	"sort"
+
+	"github.com/wmnsk/gopcua/datatypes"
+	"github.com/wmnsk/gopcua/errors"
+	"github.com/wmnsk/gopcua/id"
+)
+
+func init() {
+	datatypes.RegisterExtensionObject(
+		datatypes.NewFourByteNodeID(0, id.DataChangeNotification_Encoding_DefaultBinary),
+		func() datatypes.Data { return &DataChangeNotification{} },
+	)
+}
+
+// MonitoredItemNotification represents a change of the value of a MonitoredItem.
+//
+// Specification: Part 4, 7.20.2
+type MonitoredItemNotification struct {
+	// Client-supplied id of the MonitoredItem given in MonitoringParameters.
+	ClientHandle uint32
+	Value        *datatypes.DataValue
+}
+
+// NewMonitoredItemNotification creates a new MonitoredItemNotification.
+func NewMonitoredItemNotification(handle uint32, value *datatypes.DataValue) *MonitoredItemNotification {
+	return &MonitoredItemNotification{
+		ClientHandle: handle,
+		Value:        value,
+	}
+}
+
+// DecodeMonitoredItemNotification decodes given bytes into MonitoredItemNotification.
+func DecodeMonitoredItemNotification(b []byte) (*MonitoredItemNotification, error) {
+	m := &MonitoredItemNotification{}
+	if err := m.DecodeFromBytes(b); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// DecodeFromBytes decodes given bytes into MonitoredItemNotification.
+func (m *MonitoredItemNotification) DecodeFromBytes(b []byte) error {
+	offset := 0
+	m.ClientHandle = binary.LittleEndian.Uint32(b[offset : offset+4])
+	offset += 4
+
+	m.Value = &datatypes.DataValue{}
+	return m.Value.DecodeFromBytes(b[offset:])
+}
+
+// Serialize serializes MonitoredItemNotification into bytes.
+func (m *MonitoredItemNotification) Serialize() ([]byte, error) {
+	b := make([]byte, m.Len())
+	if err := m.SerializeTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// SerializeTo serializes MonitoredItemNotification into bytes.
+func (m *MonitoredItemNotification) SerializeTo(b []byte) error {
+	offset := 0
+	binary.LittleEndian.PutUint32(b[offset:offset+4], m.ClientHandle)
+	offset += 4
+
+	if m.Value != nil {
+		return m.Value.SerializeTo(b[offset:])
+	}
+
+	return nil
+}
+
+// Len returns the actual length of MonitoredItemNotification in int.
+func (m *MonitoredItemNotification) Len() int {
+	// ClientHandle
+	l := 4
+	if m.Value != nil {
+		l += m.Value.Len()
+	}
+
+	return l
+}
+
+// MonitoredItemNotificationArray represents an array of MonitoredItemNotifications.
+// It does not correspond to a certain type from the specification
+// but makes encoding and decoding easier.
+type MonitoredItemNotificationArray struct {
+	ArraySize      int32
+	MonitoredItems []*MonitoredItemNotification
+}
+
+// NewMonitoredItemNotificationArray creates a new MonitoredItemNotificationArray from multiple MonitoredItemNotifications.
+func NewMonitoredItemNotificationArray(monitoredItems []*MonitoredItemNotification) *MonitoredItemNotificationArray {
+	if monitoredItems == nil {
+		return &MonitoredItemNotificationArray{
+			ArraySize: 0,
+		}
+	}
+
+	return &MonitoredItemNotificationArray{
+		ArraySize:      int32(len(monitoredItems)),
+		MonitoredItems: monitoredItems,
+	}
+}
+
+// DecodeMonitoredItemNotificationArray decodes given bytes into MonitoredItemNotificationArray.
+func DecodeMonitoredItemNotificationArray(b []byte) (*MonitoredItemNotificationArray, error) {
+	m := &MonitoredItemNotificationArray{}
+	if err := m.DecodeFromBytes(b); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// DecodeFromBytes decodes given bytes into MonitoredItemNotificationArray.
+func (m *MonitoredItemNotificationArray) DecodeFromBytes(b []byte) error {
+	if len(b) < 4 {
+		return errors.NewErrTooShortToDecode(m, "should be longer than 4 bytes")
+	}
+
+	m.ArraySize = int32(binary.LittleEndian.Uint32(b[:4]))
+	if m.ArraySize <= 0 {
+		return nil
+	}
+
+	offset := 4
+	for i := 1; i <= int(m.ArraySize); i++ {
+		monitoredItemNotification, err := DecodeMonitoredItemNotification(b[offset:])
+		if err != nil {
+			return err
+		}
+		m.MonitoredItems = append(m.MonitoredItems, monitoredItemNotification)
+		offset += monitoredItemNotification.Len()
+	}
+
+	return nil
+}
+
+// Serialize serializes MonitoredItemNotificationArray into bytes.
+func (m *MonitoredItemNotificationArray) Serialize() ([]byte, error) {
+	b := make([]byte, m.Len())
+	if err := m.SerializeTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// SerializeTo serializes MonitoredItemNotificationArray into bytes.
+func (m *MonitoredItemNotificationArray) SerializeTo(b []byte) error {
+	offset := 4
+	binary.LittleEndian.PutUint32(b[:4], uint32(m.ArraySize))
+
+	for _, monitoredItemNotification := range m.MonitoredItems {
+		if err := monitoredItemNotification.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += monitoredItemNotification.Len()
+	}
+
+	return nil
+}
+
+// Len returns the actual length of MonitoredItemNotificationArray in int.
+func (m *MonitoredItemNotificationArray) Len() int {
+	l := 4
+	for _, monitoredItemNotification := range m.MonitoredItems {
+		l += monitoredItemNotification.Len()
+	}
+
+	return l
+}
+
+// DataChangeNotification is the NotificationData which contains the changes of the values
+// of MonitoredItems. It is sent as an ExtensionObject in NotificationData of NotificationMessage.
+//
+// If the QueueSize of a MonitoredItem is larger than one, MonitoredItems may contain
+// more than one MonitoredItemNotification for the MonitoredItem.
+//
+// Specification: Part 4, 7.20.2
+type DataChangeNotification struct {
+	MonitoredItems  *MonitoredItemNotificationArray
+	DiagnosticInfos *DiagnosticInfoArray
+}
+
+// NewDataChangeNotification creates a new DataChangeNotification.
+func NewDataChangeNotification(items []*MonitoredItemNotification, diags []*DiagnosticInfo) *DataChangeNotification {
+	return &DataChangeNotification{
+		MonitoredItems:  NewMonitoredItemNotificationArray(items),
+		DiagnosticInfos: NewDiagnosticInfoArray(diags),
+	}
+}
+
+// DecodeDataChangeNotification decodes given bytes into DataChangeNotification.
+func DecodeDataChangeNotification(b []byte) (*DataChangeNotification, error) {
+	d := &DataChangeNotification{}
+	if err := d.DecodeFromBytes(b); err != nil {
+		return nil, err
+	}
+
+	return d, nil
+}
+
+// DecodeFromBytes decodes given bytes into DataChangeNotification.
+func (d *DataChangeNotification) DecodeFromBytes(b []byte) error {
+	offset := 0
+	d.MonitoredItems = &MonitoredItemNotificationArray{}
+	if err := d.MonitoredItems.DecodeFromBytes(b[offset:]); err != nil {
+		return err
+	}
+	offset += d.MonitoredItems.Len()
+
+	d.DiagnosticInfos = &DiagnosticInfoArray{}
+	return d.DiagnosticInfos.DecodeFromBytes(b[offset:])
+}
+
+// Serialize serializes DataChangeNotification into bytes.
+func (d *DataChangeNotification) Serialize() ([]byte, error) {
+	b := make([]byte, d.Len())
+	if err := d.SerializeTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// SerializeTo serializes DataChangeNotification into bytes.
+func (d *DataChangeNotification) SerializeTo(b []byte) error {
+	offset := 0
+	if d.MonitoredItems != nil {
+		if err := d.MonitoredItems.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += d.MonitoredItems.Len()
+	}
+
+	if d.DiagnosticInfos != nil {
+		return d.DiagnosticInfos.SerializeTo(b[offset:])
+	}
+
+	return nil
+}
+
+// Len returns the actual length of DataChangeNotification in int.
+func (d *DataChangeNotification) Len() int {
+	l := 0
+	if d.MonitoredItems != nil {
+		l += d.MonitoredItems.Len()
+	}
+	if d.DiagnosticInfos != nil {
+		l += d.DiagnosticInfos.Len()
+	}
+
+	return l
+}
+
+// DataType returns type of Data.
+func (d *DataChangeNotification) DataType() uint16 {
+	return id.Structure
+}
+
+// ExtensionObject returns DataChangeNotification in ExtensionObject, which can be used
+// as the NotificationData in NotificationMessage.
+func (d *DataChangeNotification) ExtensionObject() *datatypes.ExtensionObject {
+	e := &datatypes.ExtensionObject{
+		TypeID: datatypes.NewExpandedNodeID(
+			false, false,
+			datatypes.NewFourByteNodeID(0, id.DataChangeNotification_Encoding_DefaultBinary),
+			"", 0,
+		),
+		EncodingMask: 0x01,
+		Value:        d,
+	}
+	e.SetLength()
+
+	return e
+}
+
+// ItemNotifications returns all the MonitoredItemNotifications for the MonitoredItem
+// with the ClientHandle in the order of the SourceTimestamp, including the queued ones.
+func (d *DataChangeNotification) ItemNotifications(handle uint32) []*MonitoredItemNotification {
+	if d.MonitoredItems == nil {
+		return nil
+	}
+
+	var items []*MonitoredItemNotification
+	for _, item := range d.MonitoredItems.MonitoredItems {
+		if item.ClientHandle == handle {
+			items = append(items, item)
+		}
+	}
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].Value.SourceTime().Before(items[j].Value.SourceTime())
+	})
+
+	return items
+}
@@ -0,0 +1,113 @@
+// Copyright 2018 gopcua authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/wmnsk/gopcua/datatypes"
+)
+
+func newTestDataValue(v float32, ts time.Time) *datatypes.DataValue {
+	return &datatypes.DataValue{
+		EncodingMask:    0x05,
+		Value:           datatypes.NewVariant(datatypes.NewFloat(v)),
+		SourceTimestamp: ts,
+	}
+}
+
+var dataChangeNotificationBytes = []byte{
+	// MonitoredItems
+	// ArraySize
+	0x01, 0x00, 0x00, 0x00,
+	// ClientHandle
+	0x01, 0x00, 0x00, 0x00,
+	// Value
+	0x05, 0x0a, 0x19, 0x04, 0x20, 0x40,
+	0x00, 0x98, 0x67, 0xdd, 0xfd, 0x30, 0xd4, 0x01,
+	// DiagnosticInfos
+	0x00, 0x00, 0x00, 0x00,
+}
+
+func TestDataChangeNotification(t *testing.T) {
+	n := NewDataChangeNotification(
+		[]*MonitoredItemNotification{
+			NewMonitoredItemNotification(1, newTestDataValue(2.50025, time.Date(2018, time.August, 10, 23, 0, 0, 0, time.UTC))),
+		},
+		nil,
+	)
+
+	t.Run("serialize", func(t *testing.T) {
+		b, err := n.Serialize()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(b, dataChangeNotificationBytes); diff != "" {
+			t.Error(diff)
+		}
+	})
+	t.Run("decode", func(t *testing.T) {
+		got, err := DecodeDataChangeNotification(dataChangeNotificationBytes)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, n, decodeCmpOpt); diff != "" {
+			t.Error(diff)
+		}
+	})
+	t.Run("notification-message", func(t *testing.T) {
+		m := NewNotificationMessage(1, time.Date(2018, time.August, 10, 23, 0, 0, 0, time.UTC), n.ExtensionObject())
+		b, err := m.Serialize()
+		if err != nil {
+			t.Fatal(err)
+		}
+		header := []byte{
+			// TypeID
+			0x01, 0x00, 0x2b, 0x03,
+			// EncodingMask
+			0x01,
+			// Length
+			0x1a, 0x00, 0x00, 0x00,
+		}
+		// SequenceNumber + PublishTime + ArraySize
+		if diff := cmp.Diff(b[16:], append(header, dataChangeNotificationBytes...)); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err := DecodeNotificationMessage(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got.NotificationData.ExtensionObjects[0].Value, n, decodeCmpOpt); diff != "" {
+			t.Error(diff)
+		}
+	})
+	t.Run("len", func(t *testing.T) {
+		if n.Len() != len(dataChangeNotificationBytes) {
+			t.Errorf("Len doesn't match. Want: %d, Got: %d", len(dataChangeNotificationBytes), n.Len())
+		}
+	})
+}
+
+func TestDataChangeNotificationItemNotifications(t *testing.T) {
+	ts := time.Date(2018, time.August, 10, 23, 0, 0, 0, time.UTC)
+	first := NewMonitoredItemNotification(1, newTestDataValue(1, ts))
+	second := NewMonitoredItemNotification(1, newTestDataValue(2, ts.Add(time.Second)))
+	third := NewMonitoredItemNotification(1, newTestDataValue(3, ts.Add(2*time.Second)))
+	other := NewMonitoredItemNotification(2, newTestDataValue(4, ts))
+
+	n := NewDataChangeNotification([]*MonitoredItemNotification{second, other, third, first}, nil)
+
+	want := []*MonitoredItemNotification{first, second, third}
+	if diff := cmp.Diff(n.ItemNotifications(1), want); diff != "" {
+		t.Error(diff)
+	}
+	if got := n.ItemNotifications(3); len(got) != 0 {
+		t.Errorf("expected no notifications, got %v", got)
+	}
+}
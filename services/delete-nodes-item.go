@@ -0,0 +1,184 @@
+// Copyright 2018 gopcua authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package services
+
+import (
+	"encoding/binary"
+
+	"github.com/wmnsk/gopcua/datatypes"
+	"github.com/wmnsk/gopcua/errors"
+)
+
+// DeleteNodesItem represents a Node to be deleted in DeleteNodesRequest.
+//
+// Specification: Part 4, 5.7.4.2
+type DeleteNodesItem struct {
+	NodeID datatypes.NodeID
+
+	// If true, the References for which the Node is the target are deleted as well.
+	DeleteTargetReferences *datatypes.Boolean
+}
+
+// NewDeleteNodesItem creates a new DeleteNodesItem.
+func NewDeleteNodesItem(nodeID datatypes.NodeID, deleteRefs bool) *DeleteNodesItem {
+	return &DeleteNodesItem{
+		NodeID:                 nodeID,
+		DeleteTargetReferences: datatypes.NewBoolean(deleteRefs),
+	}
+}
+
+// DecodeDeleteNodesItem decodes given bytes into DeleteNodesItem.
+func DecodeDeleteNodesItem(b []byte) (*DeleteNodesItem, error) {
+	d := &DeleteNodesItem{}
+	if err := d.DecodeFromBytes(b); err != nil {
+		return nil, err
+	}
+
+	return d, nil
+}
+
+// DecodeFromBytes decodes given bytes into DeleteNodesItem.
+func (d *DeleteNodesItem) DecodeFromBytes(b []byte) error {
+	nodeID, err := datatypes.DecodeNodeID(b)
+	if err != nil {
+		return err
+	}
+	d.NodeID = nodeID
+	offset := d.NodeID.Len()
+
+	d.DeleteTargetReferences = &datatypes.Boolean{}
+	return d.DeleteTargetReferences.DecodeFromBytes(b[offset:])
+}
+
+// Serialize serializes DeleteNodesItem into bytes.
+func (d *DeleteNodesItem) Serialize() ([]byte, error) {
+	b := make([]byte, d.Len())
+	if err := d.SerializeTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// SerializeTo serializes DeleteNodesItem into bytes.
+func (d *DeleteNodesItem) SerializeTo(b []byte) error {
+	offset := 0
+	if d.NodeID != nil {
+		if err := d.NodeID.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += d.NodeID.Len()
+	}
+
+	if d.DeleteTargetReferences != nil {
+		return d.DeleteTargetReferences.SerializeTo(b[offset:])
+	}
+
+	return nil
+}
+
+// Len returns the actual length of DeleteNodesItem in int.
+func (d *DeleteNodesItem) Len() int {
+	l := 0
+	if d.NodeID != nil {
+		l += d.NodeID.Len()
+	}
+	if d.DeleteTargetReferences != nil {
+		l += d.DeleteTargetReferences.Len()
+	}
+
+	return l
+}
+
+// DeleteNodesItemArray represents an array of DeleteNodesItems.
+// It does not correspond to a certain type from the specification
+// but makes encoding and decoding easier.
+type DeleteNodesItemArray struct {
+	ArraySize     int32
+	NodesToDelete []*DeleteNodesItem
+}
+
+// NewDeleteNodesItemArray creates a new DeleteNodesItemArray from multiple DeleteNodesItems.
+func NewDeleteNodesItemArray(nodesToDelete []*DeleteNodesItem) *DeleteNodesItemArray {
+	if nodesToDelete == nil {
+		return &DeleteNodesItemArray{
+			ArraySize: 0,
+		}
+	}
+
+	return &DeleteNodesItemArray{
+		ArraySize:     int32(len(nodesToDelete)),
+		NodesToDelete: nodesToDelete,
+	}
+}
+
+// DecodeDeleteNodesItemArray decodes given bytes into DeleteNodesItemArray.
+func DecodeDeleteNodesItemArray(b []byte) (*DeleteNodesItemArray, error) {
+	d := &DeleteNodesItemArray{}
+	if err := d.DecodeFromBytes(b); err != nil {
+		return nil, err
+	}
+
+	return d, nil
+}
+
+// DecodeFromBytes decodes given bytes into DeleteNodesItemArray.
+func (d *DeleteNodesItemArray) DecodeFromBytes(b []byte) error {
+	if len(b) < 4 {
+		return errors.NewErrTooShortToDecode(d, "should be longer than 4 bytes")
+	}
+
+	d.ArraySize = int32(binary.LittleEndian.Uint32(b[:4]))
+	if d.ArraySize <= 0 {
+		return nil
+	}
+
+	offset := 4
+	for i := 1; i <= int(d.ArraySize); i++ {
+		deleteNodesItem, err := DecodeDeleteNodesItem(b[offset:])
+		if err != nil {
+			return err
+		}
+		d.NodesToDelete = append(d.NodesToDelete, deleteNodesItem)
+		offset += deleteNodesItem.Len()
+	}
+
+	return nil
+}
+
+// Serialize serializes DeleteNodesItemArray into bytes.
+func (d *DeleteNodesItemArray) Serialize() ([]byte, error) {
+	b := make([]byte, d.Len())
+	if err := d.SerializeTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// SerializeTo serializes DeleteNodesItemArray into bytes.
+func (d *DeleteNodesItemArray) SerializeTo(b []byte) error {
+	offset := 4
+	binary.LittleEndian.PutUint32(b[:4], uint32(d.ArraySize))
+
+	for _, deleteNodesItem := range d.NodesToDelete {
+		if err := deleteNodesItem.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += deleteNodesItem.Len()
+	}
+
+	return nil
+}
+
+// Len returns the actual length of DeleteNodesItemArray in int.
+func (d *DeleteNodesItemArray) Len() int {
+	l := 4
+	for _, deleteNodesItem := range d.NodesToDelete {
+		l += deleteNodesItem.Len()
+	}
+
+	return l
+}
@@ -0,0 +1,135 @@
+// Copyright 2018 gopcua authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package services
+
+import (
+	"time"
+
+	"github.com/wmnsk/gopcua/datatypes"
+)
+
+// DeleteNodesRequest is used to delete one or more Nodes from the AddressSpace.
+//
+// Specification: Part 4, 5.7.4.2
+type DeleteNodesRequest struct {
+	TypeID *datatypes.ExpandedNodeID
+	*RequestHeader
+	NodesToDelete *DeleteNodesItemArray
+}
+
+// NewDeleteNodesRequest creates a new DeleteNodesRequest.
+func NewDeleteNodesRequest(ts time.Time, authToken datatypes.NodeID, handle, diag, timeout uint32, auditID string, items ...*DeleteNodesItem) *DeleteNodesRequest {
+	return &DeleteNodesRequest{
+		TypeID: datatypes.NewExpandedNodeID(
+			false, false,
+			datatypes.NewFourByteNodeID(
+				0, ServiceTypeDeleteNodesRequest,
+			),
+			"", 0,
+		),
+		RequestHeader: NewRequestHeader(
+			authToken,
+			ts,
+			handle,
+			diag,
+			timeout,
+			auditID,
+			NewAdditionalHeader(
+				datatypes.NewExpandedNodeID(
+					false, false,
+					datatypes.NewTwoByteNodeID(0),
+					"", 0,
+				),
+				0x00,
+			),
+			nil,
+		),
+		NodesToDelete: NewDeleteNodesItemArray(items),
+	}
+}
+
+// DecodeDeleteNodesRequest decodes given bytes into DeleteNodesRequest.
+func DecodeDeleteNodesRequest(b []byte) (*DeleteNodesRequest, error) {
+	d := &DeleteNodesRequest{}
+	if err := d.DecodeFromBytes(b); err != nil {
+		return nil, err
+	}
+
+	return d, nil
+}
+
+// DecodeFromBytes decodes given bytes into DeleteNodesRequest.
+func (d *DeleteNodesRequest) DecodeFromBytes(b []byte) error {
+	offset := 0
+	d.TypeID = &datatypes.ExpandedNodeID{}
+	if err := d.TypeID.DecodeFromBytes(b[offset:]); err != nil {
+		return err
+	}
+	offset += d.TypeID.Len()
+
+	d.RequestHeader = &RequestHeader{}
+	if err := d.RequestHeader.DecodeFromBytes(b[offset:]); err != nil {
+		return err
+	}
+	offset += d.RequestHeader.Len() - len(d.RequestHeader.Payload)
+
+	d.NodesToDelete = &DeleteNodesItemArray{}
+	return d.NodesToDelete.DecodeFromBytes(b[offset:])
+}
+
+// Serialize serializes DeleteNodesRequest into bytes.
+func (d *DeleteNodesRequest) Serialize() ([]byte, error) {
+	b := make([]byte, d.Len())
+	if err := d.SerializeTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// SerializeTo serializes DeleteNodesRequest into bytes.
+func (d *DeleteNodesRequest) SerializeTo(b []byte) error {
+	offset := 0
+	if d.TypeID != nil {
+		if err := d.TypeID.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += d.TypeID.Len()
+	}
+
+	if d.RequestHeader != nil {
+		if err := d.RequestHeader.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += d.RequestHeader.Len() - len(d.Payload)
+	}
+
+	if d.NodesToDelete != nil {
+		return d.NodesToDelete.SerializeTo(b[offset:])
+	}
+
+	return nil
+}
+
+// Len returns the actual length of DeleteNodesRequest in int.
+func (d *DeleteNodesRequest) Len() int {
+	l := 0
+	if d.TypeID != nil {
+		l += d.TypeID.Len()
+	}
+	if d.RequestHeader != nil {
+		l += (d.RequestHeader.Len() - len(d.Payload))
+	}
+	if d.NodesToDelete != nil {
+		l += d.NodesToDelete.Len()
+	}
+
+	return l
+}
+
+// ServiceType returns type of Service in uint16.
+func (d *DeleteNodesRequest) ServiceType() uint16 {
+	return ServiceTypeDeleteNodesRequest
+}
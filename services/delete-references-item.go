@@ -0,0 +1,239 @@
+// Copyright 2018 gopcua authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package services
+
+import (
+	"encoding/binary"
+
+	"github.com/wmnsk/gopcua/datatypes"
+	"github.com/wmnsk/gopcua/errors"
+)
+
+// DeleteReferencesItem represents a Reference to be deleted in DeleteReferencesRequest.
+//
+// Specification: Part 4, 5.7.5.2
+type DeleteReferencesItem struct {
+	SourceNodeID    datatypes.NodeID
+	ReferenceTypeID datatypes.NodeID
+	IsForward       *datatypes.Boolean
+	TargetNodeID    *datatypes.ExpandedNodeID
+
+	// If true, the opposite Reference from the target Node is deleted as well.
+	DeleteBidirectional *datatypes.Boolean
+}
+
+// NewDeleteReferencesItem creates a new DeleteReferencesItem.
+func NewDeleteReferencesItem(srcID datatypes.NodeID, refTypeID datatypes.NodeID, isForward bool, targetID *datatypes.ExpandedNodeID, bidirectional bool) *DeleteReferencesItem {
+	return &DeleteReferencesItem{
+		SourceNodeID:        srcID,
+		ReferenceTypeID:     refTypeID,
+		IsForward:           datatypes.NewBoolean(isForward),
+		TargetNodeID:        targetID,
+		DeleteBidirectional: datatypes.NewBoolean(bidirectional),
+	}
+}
+
+// DecodeDeleteReferencesItem decodes given bytes into DeleteReferencesItem.
+func DecodeDeleteReferencesItem(b []byte) (*DeleteReferencesItem, error) {
+	d := &DeleteReferencesItem{}
+	if err := d.DecodeFromBytes(b); err != nil {
+		return nil, err
+	}
+
+	return d, nil
+}
+
+// DecodeFromBytes decodes given bytes into DeleteReferencesItem.
+func (d *DeleteReferencesItem) DecodeFromBytes(b []byte) error {
+	sourceNodeID, err := datatypes.DecodeNodeID(b)
+	if err != nil {
+		return err
+	}
+	d.SourceNodeID = sourceNodeID
+	offset := d.SourceNodeID.Len()
+
+	referenceTypeID, err := datatypes.DecodeNodeID(b[offset:])
+	if err != nil {
+		return err
+	}
+	d.ReferenceTypeID = referenceTypeID
+	offset += d.ReferenceTypeID.Len()
+
+	d.IsForward = &datatypes.Boolean{}
+	if err := d.IsForward.DecodeFromBytes(b[offset:]); err != nil {
+		return err
+	}
+	offset += d.IsForward.Len()
+
+	d.TargetNodeID = &datatypes.ExpandedNodeID{}
+	if err := d.TargetNodeID.DecodeFromBytes(b[offset:]); err != nil {
+		return err
+	}
+	offset += d.TargetNodeID.Len()
+
+	d.DeleteBidirectional = &datatypes.Boolean{}
+	return d.DeleteBidirectional.DecodeFromBytes(b[offset:])
+}
+
+// Serialize serializes DeleteReferencesItem into bytes.
+func (d *DeleteReferencesItem) Serialize() ([]byte, error) {
+	b := make([]byte, d.Len())
+	if err := d.SerializeTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// SerializeTo serializes DeleteReferencesItem into bytes.
+func (d *DeleteReferencesItem) SerializeTo(b []byte) error {
+	offset := 0
+	if d.SourceNodeID != nil {
+		if err := d.SourceNodeID.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += d.SourceNodeID.Len()
+	}
+
+	if d.ReferenceTypeID != nil {
+		if err := d.ReferenceTypeID.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += d.ReferenceTypeID.Len()
+	}
+
+	if d.IsForward != nil {
+		if err := d.IsForward.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += d.IsForward.Len()
+	}
+
+	if d.TargetNodeID != nil {
+		if err := d.TargetNodeID.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += d.TargetNodeID.Len()
+	}
+
+	if d.DeleteBidirectional != nil {
+		return d.DeleteBidirectional.SerializeTo(b[offset:])
+	}
+
+	return nil
+}
+
+// Len returns the actual length of DeleteReferencesItem in int.
+func (d *DeleteReferencesItem) Len() int {
+	l := 0
+	if d.SourceNodeID != nil {
+		l += d.SourceNodeID.Len()
+	}
+	if d.ReferenceTypeID != nil {
+		l += d.ReferenceTypeID.Len()
+	}
+	if d.IsForward != nil {
+		l += d.IsForward.Len()
+	}
+	if d.TargetNodeID != nil {
+		l += d.TargetNodeID.Len()
+	}
+	if d.DeleteBidirectional != nil {
+		l += d.DeleteBidirectional.Len()
+	}
+
+	return l
+}
+
+// DeleteReferencesItemArray represents an array of DeleteReferencesItems.
+// It does not correspond to a certain type from the specification
+// but makes encoding and decoding easier.
+type DeleteReferencesItemArray struct {
+	ArraySize          int32
+	ReferencesToDelete []*DeleteReferencesItem
+}
+
+// NewDeleteReferencesItemArray creates a new DeleteReferencesItemArray from multiple DeleteReferencesItems.
+func NewDeleteReferencesItemArray(referencesToDelete []*DeleteReferencesItem) *DeleteReferencesItemArray {
+	if referencesToDelete == nil {
+		return &DeleteReferencesItemArray{
+			ArraySize: 0,
+		}
+	}
+
+	return &DeleteReferencesItemArray{
+		ArraySize:          int32(len(referencesToDelete)),
+		ReferencesToDelete: referencesToDelete,
+	}
+}
+
+// DecodeDeleteReferencesItemArray decodes given bytes into DeleteReferencesItemArray.
+func DecodeDeleteReferencesItemArray(b []byte) (*DeleteReferencesItemArray, error) {
+	d := &DeleteReferencesItemArray{}
+	if err := d.DecodeFromBytes(b); err != nil {
+		return nil, err
+	}
+
+	return d, nil
+}
+
+// DecodeFromBytes decodes given bytes into DeleteReferencesItemArray.
+func (d *DeleteReferencesItemArray) DecodeFromBytes(b []byte) error {
+	if len(b) < 4 {
+		return errors.NewErrTooShortToDecode(d, "should be longer than 4 bytes")
+	}
+
+	d.ArraySize = int32(binary.LittleEndian.Uint32(b[:4]))
+	if d.ArraySize <= 0 {
+		return nil
+	}
+
+	offset := 4
+	for i := 1; i <= int(d.ArraySize); i++ {
+		deleteReferencesItem, err := DecodeDeleteReferencesItem(b[offset:])
+		if err != nil {
+			return err
+		}
+		d.ReferencesToDelete = append(d.ReferencesToDelete, deleteReferencesItem)
+		offset += deleteReferencesItem.Len()
+	}
+
+	return nil
+}
+
+// Serialize serializes DeleteReferencesItemArray into bytes.
+func (d *DeleteReferencesItemArray) Serialize() ([]byte, error) {
+	b := make([]byte, d.Len())
+	if err := d.SerializeTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// SerializeTo serializes DeleteReferencesItemArray into bytes.
+func (d *DeleteReferencesItemArray) SerializeTo(b []byte) error {
+	offset := 4
+	binary.LittleEndian.PutUint32(b[:4], uint32(d.ArraySize))
+
+	for _, deleteReferencesItem := range d.ReferencesToDelete {
+		if err := deleteReferencesItem.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += deleteReferencesItem.Len()
+	}
+
+	return nil
+}
+
+// Len returns the actual length of DeleteReferencesItemArray in int.
+func (d *DeleteReferencesItemArray) Len() int {
+	l := 4
+	for _, deleteReferencesItem := range d.ReferencesToDelete {
+		l += deleteReferencesItem.Len()
+	}
+
+	return l
+}
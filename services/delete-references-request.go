@@ -0,0 +1,135 @@
+// Copyright 2018 gopcua authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package services
+
+import (
+	"time"
+
+	"github.com/wmnsk/gopcua/datatypes"
+)
+
+// DeleteReferencesRequest is used to delete one or more References of a Node.
+//
+// Specification: Part 4, 5.7.5.2
+type DeleteReferencesRequest struct {
+	TypeID *datatypes.ExpandedNodeID
+	*RequestHeader
+	ReferencesToDelete *DeleteReferencesItemArray
+}
+
+// NewDeleteReferencesRequest creates a new DeleteReferencesRequest.
+func NewDeleteReferencesRequest(ts time.Time, authToken datatypes.NodeID, handle, diag, timeout uint32, auditID string, items ...*DeleteReferencesItem) *DeleteReferencesRequest {
+	return &DeleteReferencesRequest{
+		TypeID: datatypes.NewExpandedNodeID(
+			false, false,
+			datatypes.NewFourByteNodeID(
+				0, ServiceTypeDeleteReferencesRequest,
+			),
+			"", 0,
+		),
+		RequestHeader: NewRequestHeader(
+			authToken,
+			ts,
+			handle,
+			diag,
+			timeout,
+			auditID,
+			NewAdditionalHeader(
+				datatypes.NewExpandedNodeID(
+					false, false,
+					datatypes.NewTwoByteNodeID(0),
+					"", 0,
+				),
+				0x00,
+			),
+			nil,
+		),
+		ReferencesToDelete: NewDeleteReferencesItemArray(items),
+	}
+}
+
+// DecodeDeleteReferencesRequest decodes given bytes into DeleteReferencesRequest.
+func DecodeDeleteReferencesRequest(b []byte) (*DeleteReferencesRequest, error) {
+	d := &DeleteReferencesRequest{}
+	if err := d.DecodeFromBytes(b); err != nil {
+		return nil, err
+	}
+
+	return d, nil
+}
+
+// DecodeFromBytes decodes given bytes into DeleteReferencesRequest.
+func (d *DeleteReferencesRequest) DecodeFromBytes(b []byte) error {
+	offset := 0
+	d.TypeID = &datatypes.ExpandedNodeID{}
+	if err := d.TypeID.DecodeFromBytes(b[offset:]); err != nil {
+		return err
+	}
+	offset += d.TypeID.Len()
+
+	d.RequestHeader = &RequestHeader{}
+	if err := d.RequestHeader.DecodeFromBytes(b[offset:]); err != nil {
+		return err
+	}
+	offset += d.RequestHeader.Len() - len(d.RequestHeader.Payload)
+
+	d.ReferencesToDelete = &DeleteReferencesItemArray{}
+	return d.ReferencesToDelete.DecodeFromBytes(b[offset:])
+}
+
+// Serialize serializes DeleteReferencesRequest into bytes.
+func (d *DeleteReferencesRequest) Serialize() ([]byte, error) {
+	b := make([]byte, d.Len())
+	if err := d.SerializeTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// SerializeTo serializes DeleteReferencesRequest into bytes.
+func (d *DeleteReferencesRequest) SerializeTo(b []byte) error {
+	offset := 0
+	if d.TypeID != nil {
+		if err := d.TypeID.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += d.TypeID.Len()
+	}
+
+	if d.RequestHeader != nil {
+		if err := d.RequestHeader.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += d.RequestHeader.Len() - len(d.Payload)
+	}
+
+	if d.ReferencesToDelete != nil {
+		return d.ReferencesToDelete.SerializeTo(b[offset:])
+	}
+
+	return nil
+}
+
+// Len returns the actual length of DeleteReferencesRequest in int.
+func (d *DeleteReferencesRequest) Len() int {
+	l := 0
+	if d.TypeID != nil {
+		l += d.TypeID.Len()
+	}
+	if d.RequestHeader != nil {
+		l += (d.RequestHeader.Len() - len(d.Payload))
+	}
+	if d.ReferencesToDelete != nil {
+		l += d.ReferencesToDelete.Len()
+	}
+
+	return l
+}
+
+// ServiceType returns type of Service in uint16.
+func (d *DeleteReferencesRequest) ServiceType() uint16 {
+	return ServiceTypeDeleteReferencesRequest
+}
@@ -0,0 +1,153 @@
+// Copyright 2018 gopcua authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package services
+
+import (
+	"time"
+
+	"github.com/wmnsk/gopcua/datatypes"
+)
+
+// DeleteReferencesResponse represents the response to a DeleteReferencesRequest.
+// Results are the StatusCodes in the same order as the items in the request.
+//
+// Specification: Part 4, 5.7.5.2
+type DeleteReferencesResponse struct {
+	TypeID *datatypes.ExpandedNodeID
+	*ResponseHeader
+	Results         *datatypes.Uint32Array
+	DiagnosticInfos *DiagnosticInfoArray
+}
+
+// NewDeleteReferencesResponse creates a new DeleteReferencesResponse.
+func NewDeleteReferencesResponse(ts time.Time, handle, code uint32, diag *DiagnosticInfo, strs []string, results []uint32, diags []*DiagnosticInfo) *DeleteReferencesResponse {
+	return &DeleteReferencesResponse{
+		TypeID: datatypes.NewExpandedNodeID(
+			false, false,
+			datatypes.NewFourByteNodeID(
+				0, ServiceTypeDeleteReferencesResponse,
+			),
+			"", 0,
+		),
+		ResponseHeader: NewResponseHeader(
+			ts,
+			handle,
+			code,
+			diag,
+			strs,
+			NewAdditionalHeader(
+				datatypes.NewExpandedNodeID(
+					false, false,
+					datatypes.NewTwoByteNodeID(0),
+					"", 0,
+				),
+				0x00,
+			),
+			nil,
+		),
+		Results:         datatypes.NewUint32Array(results),
+		DiagnosticInfos: NewDiagnosticInfoArray(diags),
+	}
+}
+
+// DecodeDeleteReferencesResponse decodes given bytes into DeleteReferencesResponse.
+func DecodeDeleteReferencesResponse(b []byte) (*DeleteReferencesResponse, error) {
+	d := &DeleteReferencesResponse{}
+	if err := d.DecodeFromBytes(b); err != nil {
+		return nil, err
+	}
+
+	return d, nil
+}
+
+// DecodeFromBytes decodes given bytes into DeleteReferencesResponse.
+func (d *DeleteReferencesResponse) DecodeFromBytes(b []byte) error {
+	offset := 0
+	d.TypeID = &datatypes.ExpandedNodeID{}
+	if err := d.TypeID.DecodeFromBytes(b[offset:]); err != nil {
+		return err
+	}
+	offset += d.TypeID.Len()
+
+	d.ResponseHeader = &ResponseHeader{}
+	if err := d.ResponseHeader.DecodeFromBytes(b[offset:]); err != nil {
+		return err
+	}
+	offset += d.ResponseHeader.Len() - len(d.ResponseHeader.Payload)
+
+	d.Results = &datatypes.Uint32Array{}
+	if err := d.Results.DecodeFromBytes(b[offset:]); err != nil {
+		return err
+	}
+	offset += d.Results.Len()
+
+	d.DiagnosticInfos = &DiagnosticInfoArray{}
+	return d.DiagnosticInfos.DecodeFromBytes(b[offset:])
+}
+
+// Serialize serializes DeleteReferencesResponse into bytes.
+func (d *DeleteReferencesResponse) Serialize() ([]byte, error) {
+	b := make([]byte, d.Len())
+	if err := d.SerializeTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// SerializeTo serializes DeleteReferencesResponse into bytes.
+func (d *DeleteReferencesResponse) SerializeTo(b []byte) error {
+	offset := 0
+	if d.TypeID != nil {
+		if err := d.TypeID.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += d.TypeID.Len()
+	}
+
+	if d.ResponseHeader != nil {
+		if err := d.ResponseHeader.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += d.ResponseHeader.Len() - len(d.Payload)
+	}
+
+	if d.Results != nil {
+		if err := d.Results.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += d.Results.Len()
+	}
+
+	if d.DiagnosticInfos != nil {
+		return d.DiagnosticInfos.SerializeTo(b[offset:])
+	}
+
+	return nil
+}
+
+// Len returns the actual length of DeleteReferencesResponse in int.
+func (d *DeleteReferencesResponse) Len() int {
+	l := 0
+	if d.TypeID != nil {
+		l += d.TypeID.Len()
+	}
+	if d.ResponseHeader != nil {
+		l += (d.ResponseHeader.Len() - len(d.Payload))
+	}
+	if d.Results != nil {
+		l += d.Results.Len()
+	}
+	if d.DiagnosticInfos != nil {
+		l += d.DiagnosticInfos.Len()
+	}
+
+	return l
+}
+
+// ServiceType returns type of Service in uint16.
+func (d *DeleteReferencesResponse) ServiceType() uint16 {
+	return ServiceTypeDeleteReferencesResponse
+}
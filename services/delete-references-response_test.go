@@ -0,0 +1,101 @@
+// Copyright 2018 gopcua authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+var deleteReferencesResponseCases = []struct {
+	description string
+	structured  *DeleteReferencesResponse
+	serialized  []byte
+}{
+	{
+		"normal",
+		NewDeleteReferencesResponse(
+			time.Date(2018, time.August, 10, 23, 0, 0, 0, time.UTC),
+			1, 0, nil, nil,
+			[]uint32{0},
+			nil,
+		),
+		[]byte{ // DeleteReferencesResponse
+			// TypeID
+			0x01, 0x00, 0xfd, 0x01,
+			// ResponseHeader
+			// Timestamp
+			0x00, 0x98, 0x67, 0xdd, 0xfd, 0x30, 0xd4, 0x01,
+			// RequestHandle
+			0x01, 0x00, 0x00, 0x00,
+			// ServiceResult
+			0x00, 0x00, 0x00, 0x00,
+			// ServiceDiagnostics
+			0x00,
+			// StringTable
+			0x00, 0x00, 0x00, 0x00,
+			// AdditionalHeader
+			0x00, 0x00, 0x00,
+			// Results
+			0x01, 0x00, 0x00, 0x00,
+			0x00, 0x00, 0x00, 0x00,
+			// DiagnosticInfos
+			0x00, 0x00, 0x00, 0x00,
+		},
+	},
+}
+
+func TestDecodeDeleteReferencesResponse(t *testing.T) {
+	for _, c := range deleteReferencesResponseCases {
+		got, err := DecodeDeleteReferencesResponse(c.serialized)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// need to clear Payload here.
+		got.Payload = nil
+
+		if diff := cmp.Diff(got, c.structured, decodeCmpOpt); diff != "" {
+			t.Errorf("%s failed\n%s", c.description, diff)
+		}
+	}
+}
+
+func TestSerializeDeleteReferencesResponse(t *testing.T) {
+	for _, c := range deleteReferencesResponseCases {
+		got, err := c.structured.Serialize()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if diff := cmp.Diff(got, c.serialized); diff != "" {
+			t.Errorf("%s failed\n%s", c.description, diff)
+		}
+	}
+}
+
+func TestDeleteReferencesResponseLen(t *testing.T) {
+	for _, c := range deleteReferencesResponseCases {
+		got := c.structured.Len()
+
+		if diff := cmp.Diff(got, len(c.serialized)); diff != "" {
+			t.Errorf("%s failed\n%s", c.description, diff)
+		}
+	}
+}
+
+func TestDeleteReferencesResponseServiceType(t *testing.T) {
+	for _, c := range deleteReferencesResponseCases {
+		if c.structured.ServiceType() != ServiceTypeDeleteReferencesResponse {
+			t.Errorf(
+				"ServiceType doesn't match. Want: %d, Got: %d",
+				ServiceTypeDeleteReferencesResponse,
+				c.structured.ServiceType(),
+			)
+		}
+	}
+}
@@ -0,0 +1,135 @@
+// Copyright 2018 gopcua authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package services
+
+import (
+	"time"
+
+	"github.com/wmnsk/gopcua/datatypes"
+)
+
+// DeleteSubscriptionsRequest is used to delete one or more Subscriptions that belong to the Client's Session.
+//
+// Specification: Part 4, 5.13.8.2
+type DeleteSubscriptionsRequest struct {
+	TypeID *datatypes.ExpandedNodeID
+	*RequestHeader
+	SubscriptionIDs *datatypes.Uint32Array
+}
+
+// NewDeleteSubscriptionsRequest creates a new DeleteSubscriptionsRequest.
+func NewDeleteSubscriptionsRequest(ts time.Time, authToken datatypes.NodeID, handle, diag, timeout uint32, auditID string, ids ...uint32) *DeleteSubscriptionsRequest {
+	return &DeleteSubscriptionsRequest{
+		TypeID: datatypes.NewExpandedNodeID(
+			false, false,
+			datatypes.NewFourByteNodeID(
+				0, ServiceTypeDeleteSubscriptionsRequest,
+			),
+			"", 0,
+		),
+		RequestHeader: NewRequestHeader(
+			authToken,
+			ts,
+			handle,
+			diag,
+			timeout,
+			auditID,
+			NewAdditionalHeader(
+				datatypes.NewExpandedNodeID(
+					false, false,
+					datatypes.NewTwoByteNodeID(0),
+					"", 0,
+				),
+				0x00,
+			),
+			nil,
+		),
+		SubscriptionIDs: datatypes.NewUint32Array(ids),
+	}
+}
+
+// DecodeDeleteSubscriptionsRequest decodes given bytes into DeleteSubscriptionsRequest.
+func DecodeDeleteSubscriptionsRequest(b []byte) (*DeleteSubscriptionsRequest, error) {
+	d := &DeleteSubscriptionsRequest{}
+	if err := d.DecodeFromBytes(b); err != nil {
+		return nil, err
+	}
+
+	return d, nil
+}
+
+// DecodeFromBytes decodes given bytes into DeleteSubscriptionsRequest.
+func (d *DeleteSubscriptionsRequest) DecodeFromBytes(b []byte) error {
+	offset := 0
+	d.TypeID = &datatypes.ExpandedNodeID{}
+	if err := d.TypeID.DecodeFromBytes(b[offset:]); err != nil {
+		return err
+	}
+	offset += d.TypeID.Len()
+
+	d.RequestHeader = &RequestHeader{}
+	if err := d.RequestHeader.DecodeFromBytes(b[offset:]); err != nil {
+		return err
+	}
+	offset += d.RequestHeader.Len() - len(d.RequestHeader.Payload)
+
+	d.SubscriptionIDs = &datatypes.Uint32Array{}
+	return d.SubscriptionIDs.DecodeFromBytes(b[offset:])
+}
+
+// Serialize serializes DeleteSubscriptionsRequest into bytes.
+func (d *DeleteSubscriptionsRequest) Serialize() ([]byte, error) {
+	b := make([]byte, d.Len())
+	if err := d.SerializeTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// SerializeTo serializes DeleteSubscriptionsRequest into bytes.
+func (d *DeleteSubscriptionsRequest) SerializeTo(b []byte) error {
+	offset := 0
+	if d.TypeID != nil {
+		if err := d.TypeID.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += d.TypeID.Len()
+	}
+
+	if d.RequestHeader != nil {
+		if err := d.RequestHeader.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += d.RequestHeader.Len() - len(d.Payload)
+	}
+
+	if d.SubscriptionIDs != nil {
+		return d.SubscriptionIDs.SerializeTo(b[offset:])
+	}
+
+	return nil
+}
+
+// Len returns the actual length of DeleteSubscriptionsRequest in int.
+func (d *DeleteSubscriptionsRequest) Len() int {
+	l := 0
+	if d.TypeID != nil {
+		l += d.TypeID.Len()
+	}
+	if d.RequestHeader != nil {
+		l += (d.RequestHeader.Len() - len(d.Payload))
+	}
+	if d.SubscriptionIDs != nil {
+		l += d.SubscriptionIDs.Len()
+	}
+
+	return l
+}
+
+// ServiceType returns type of Service in uint16.
+func (d *DeleteSubscriptionsRequest) ServiceType() uint16 {
+	return ServiceTypeDeleteSubscriptionsRequest
+}
@@ -0,0 +1,102 @@
+// Copyright 2018 gopcua authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+var deleteSubscriptionsResponseCases = []struct {
+	description string
+	structured  *DeleteSubscriptionsResponse
+	serialized  []byte
+}{
+	{
+		"normal",
+		NewDeleteSubscriptionsResponse(
+			time.Date(2018, time.August, 10, 23, 0, 0, 0, time.UTC),
+			1, 0, nil, nil,
+			[]uint32{0, 0x80280000}, nil,
+		),
+		[]byte{ // DeleteSubscriptionsResponse
+			// TypeID
+			0x01, 0x00, 0x52, 0x03,
+			// ResponseHeader
+			// Timestamp
+			0x00, 0x98, 0x67, 0xdd, 0xfd, 0x30, 0xd4, 0x01,
+			// RequestHandle
+			0x01, 0x00, 0x00, 0x00,
+			// ServiceResult
+			0x00, 0x00, 0x00, 0x00,
+			// ServiceDiagnostics
+			0x00,
+			// StringTable
+			0x00, 0x00, 0x00, 0x00,
+			// AdditionalHeader
+			0x00, 0x00, 0x00,
+			// Results
+			// ArraySize
+			0x02, 0x00, 0x00, 0x00,
+			0x00, 0x00, 0x00, 0x00,
+			0x00, 0x00, 0x28, 0x80,
+			// DiagnosticInfos
+			0x00, 0x00, 0x00, 0x00,
+		},
+	},
+}
+
+func TestDecodeDeleteSubscriptionsResponse(t *testing.T) {
+	for _, c := range deleteSubscriptionsResponseCases {
+		got, err := DecodeDeleteSubscriptionsResponse(c.serialized)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// need to clear Payload here.
+		got.Payload = nil
+
+		if diff := cmp.Diff(got, c.structured, decodeCmpOpt); diff != "" {
+			t.Errorf("%s failed\n%s", c.description, diff)
+		}
+	}
+}
+
+func TestSerializeDeleteSubscriptionsResponse(t *testing.T) {
+	for _, c := range deleteSubscriptionsResponseCases {
+		got, err := c.structured.Serialize()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if diff := cmp.Diff(got, c.serialized); diff != "" {
+			t.Errorf("%s failed\n%s", c.description, diff)
+		}
+	}
+}
+
+func TestDeleteSubscriptionsResponseLen(t *testing.T) {
+	for _, c := range deleteSubscriptionsResponseCases {
+		got := c.structured.Len()
+
+		if diff := cmp.Diff(got, len(c.serialized)); diff != "" {
+			t.Errorf("%s failed\n%s", c.description, diff)
+		}
+	}
+}
+
+func TestDeleteSubscriptionsResponseServiceType(t *testing.T) {
+	for _, c := range deleteSubscriptionsResponseCases {
+		if c.structured.ServiceType() != ServiceTypeDeleteSubscriptionsResponse {
+			t.Errorf(
+				"ServiceType doesn't match. Want: %d, Got: %d",
+				ServiceTypeDeleteSubscriptionsResponse,
+				c.structured.ServiceType(),
+			)
+		}
+	}
+}
@@ -7,6 +7,7 @@ package services
 import (
 	"encoding/binary"
 	"fmt"
+	"strings"
 
 	"github.com/wmnsk/gopcua/datatypes"
 )
@@ -303,6 +304,47 @@ func (d *DiagnosticInfo) String() string {
 	return fmt.Sprintf("%v", str)
 }
 
+// Resolve returns the human-readable text of DiagnosticInfo, with the indices of
+// SymbolicID, NamespaceURI, Locale and LocalizedText resolved against table,
+// which is the StringTable in ResponseHeader. InnerDiagnosticInfo is resolved
+// recursively. An index not found in table is shown as it is, like "#3".
+func (d *DiagnosticInfo) Resolve(table *datatypes.StringArray) string {
+	var str []string
+	if d.HasSymbolicID() {
+		if d.HasNamespaceURI() {
+			str = append(str, fmt.Sprintf("symbolic id: %s:%s", lookupString(table, d.NamespaceURI), lookupString(table, d.SymbolicID)))
+		} else {
+			str = append(str, fmt.Sprintf("symbolic id: %s", lookupString(table, d.SymbolicID)))
+		}
+	}
+	if d.HasLocalizedText() {
+		if d.HasLocale() {
+			str = append(str, fmt.Sprintf("text: %s (%s)", lookupString(table, d.LocalizedText), lookupString(table, d.Locale)))
+		} else {
+			str = append(str, fmt.Sprintf("text: %s", lookupString(table, d.LocalizedText)))
+		}
+	}
+	if d.HasAdditionalInfo() && d.AdditionalInfo != nil {
+		str = append(str, fmt.Sprintf("additional info: %s", d.AdditionalInfo.Get()))
+	}
+	if d.HasInnerStatusCode() {
+		str = append(str, fmt.Sprintf("inner status: 0x%08x", d.InnerStatusCode))
+	}
+	if d.HasInnerDiagnosticInfo() && d.InnerDiagnosticInfo != nil {
+		str = append(str, fmt.Sprintf("inner diagnostics: (%s)", d.InnerDiagnosticInfo.Resolve(table)))
+	}
+
+	return strings.Join(str, ", ")
+}
+
+func lookupString(table *datatypes.StringArray, idx int32) string {
+	if table == nil || idx < 0 || int(idx) >= len(table.Strings) {
+		return fmt.Sprintf("#%d", idx)
+	}
+
+	return table.Strings[idx].Get()
+}
+
 // DiagnosticInfoArray represents the DiagnosticInfoArray.
 type DiagnosticInfoArray struct {
 	ArraySize       int32
@@ -509,3 +509,47 @@ func TestDiagnosticInfoArrayLen(t *testing.T) {
 		}
 	}
 }
+
+func TestDiagnosticInfoResolve(t *testing.T) {
+	table := datatypes.NewStringArray([]string{"http://example.com/UA/", "BadThing", "en-US", "Something went wrong"})
+	cases := []struct {
+		input *DiagnosticInfo
+		want  string
+	}{
+		{ // Nothing
+			NewNullDiagnosticInfo(),
+			"",
+		},
+		{ // Has all the indices
+			NewDiagnosticInfo(
+				true, true, true, true, false, false, false,
+				1, 0, 2, 3, nil, 0, nil,
+			),
+			"symbolic id: http://example.com/UA/:BadThing, text: Something went wrong (en-US)",
+		},
+		{ // Index out of StringTable
+			NewDiagnosticInfo(
+				true, false, false, false, false, false, false,
+				4, 0, 0, 0, nil, 0, nil,
+			),
+			"symbolic id: #4",
+		},
+		{ // Has InnerDiagnosticInfo
+			NewDiagnosticInfo(
+				false, false, false, false, true, true, true,
+				0, 0, 0, 0, datatypes.NewString("foobar"), 0x80340000,
+				NewDiagnosticInfo(
+					true, false, false, false, false, false, false,
+					1, 0, 0, 0, nil, 0, nil,
+				),
+			),
+			"additional info: foobar, inner status: 0x80340000, inner diagnostics: (symbolic id: BadThing)",
+		},
+	}
+
+	for i, c := range cases {
+		if diff := cmp.Diff(c.input.Resolve(table), c.want); diff != "" {
+			t.Errorf("case #%d failed\n%s", i, diff)
+		}
+	}
+}
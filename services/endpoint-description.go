@@ -7,11 +7,17 @@ package services
 import (
 	"encoding/binary"
 	"fmt"
+	"sort"
+	"strings"
 
 	"github.com/wmnsk/gopcua/datatypes"
 	"github.com/wmnsk/gopcua/errors"
 )
 
+// TransportProfileURIBinary is the TransportProfileURI of the endpoints with
+// UA TCP transport and UA Binary encoding, i.e. "opc.tcp".
+const TransportProfileURIBinary = "http://opcfoundation.org/UA-Profile/Transport/uatcp-uasc-uabinary"
+
 // EndpointDescription represents an EndpointDescription.
 //
 // Specification: Part 4, 7.10
@@ -289,3 +295,71 @@ func (e *EndpointDescriptionArray) Len() int {
 
 	return l
 }
+
+// Select returns the EndpointDescription that matches the given SecurityPolicyURI
+// and MessageSecurityMode.
+//
+// If policyURI is empty or mode is SecModeInvalid, any value matches for that field.
+// When multiple endpoints match, the one with the highest SecurityLevel is returned,
+// so calling Select with empty arguments picks the most secure endpoint available.
+// If no endpoint matches, the error lists the available policies and modes.
+func (e *EndpointDescriptionArray) Select(policyURI string, mode uint32) (*EndpointDescription, error) {
+	var selected *EndpointDescription
+	var available []string
+	for _, ed := range e.EndpointDescriptions {
+		var uri string
+		if ed.SecurityPolicyURI != nil {
+			uri = ed.SecurityPolicyURI.Get()
+		}
+		available = append(available, fmt.Sprintf("%s (mode: %d)", uri, ed.MessageSecurityMode))
+
+		if policyURI != "" && uri != policyURI {
+			continue
+		}
+		if mode != SecModeInvalid && ed.MessageSecurityMode != mode {
+			continue
+		}
+		if selected == nil || ed.SecurityLevel > selected.SecurityLevel {
+			selected = ed
+		}
+	}
+
+	if selected == nil {
+		return nil, errors.Errorf(
+			"no endpoint matches policy %q with mode %d, available: %s",
+			policyURI, mode, strings.Join(available, ", "),
+		)
+	}
+	return selected, nil
+}
+
+// Filter returns a new EndpointDescriptionArray that contains the endpoints with
+// one of the given TransportProfileURIs, sorted by SecurityLevel in descending order.
+//
+// If no profileURIs are given, all the endpoints are returned in sorted order.
+// The endpoints with the same SecurityLevel are kept in the order the Server returned.
+func (e *EndpointDescriptionArray) Filter(profileURIs ...string) *EndpointDescriptionArray {
+	var filtered []*EndpointDescription
+	for _, ed := range e.EndpointDescriptions {
+		if len(profileURIs) == 0 {
+			filtered = append(filtered, ed)
+			continue
+		}
+
+		var uri string
+		if ed.TransportProfileURI != nil {
+			uri = ed.TransportProfileURI.Get()
+		}
+		for _, p := range profileURIs {
+			if uri == p {
+				filtered = append(filtered, ed)
+				break
+			}
+		}
+	}
+
+	sort.SliceStable(filtered, func(i, j int) bool {
+		return filtered[i].SecurityLevel > filtered[j].SecurityLevel
+	})
+	return NewEndpointDescriptionArray(filtered)
+}
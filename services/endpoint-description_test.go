@@ -342,3 +342,82 @@ func TestSerializeEndpointDescription(t *testing.T) {
 		t.Logf("%x", serialized)
 	})
 }
+
+func TestEndpointDescriptionArraySelect(t *testing.T) {
+	const (
+		policyNone  = "http://opcfoundation.org/UA/SecurityPolicy#None"
+		policyBasic = "http://opcfoundation.org/UA/SecurityPolicy#Basic256Sha256"
+	)
+	eps := NewEndpointDescriptionArray([]*EndpointDescription{
+		NewEndpointDescription("ep-none", nil, nil, SecModeNone, policyNone, nil, "trans-uri", 0),
+		NewEndpointDescription("ep-sign", nil, nil, SecModeSign, policyBasic, nil, "trans-uri", 2),
+		NewEndpointDescription("ep-sign-encrypt", nil, nil, SecModeSignAndEncrypt, policyBasic, nil, "trans-uri", 3),
+	})
+
+	var cases = []struct {
+		description string
+		policyURI   string
+		mode        uint32
+		expected    string
+	}{
+		{"policy-and-mode", policyBasic, SecModeSign, "ep-sign"},
+		{"policy-only", policyBasic, SecModeInvalid, "ep-sign-encrypt"},
+		{"mode-only", "", SecModeNone, "ep-none"},
+		{"most-secure", "", SecModeInvalid, "ep-sign-encrypt"},
+	}
+	for _, c := range cases {
+		t.Run(c.description, func(t *testing.T) {
+			ep, err := eps.Select(c.policyURI, c.mode)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := ep.EndpointURL.Get(); got != c.expected {
+				t.Errorf("EndpointURL doesn't match. Want: %s, Got: %s", c.expected, got)
+			}
+		})
+	}
+
+	t.Run("no-match", func(t *testing.T) {
+		if _, err := eps.Select(policyNone, SecModeSign); err == nil {
+			t.Error("expected error")
+		}
+	})
+}
+
+func TestEndpointDescriptionArrayFilter(t *testing.T) {
+	const profileHTTPS = "http://opcfoundation.org/UA-Profile/Transport/https-uabinary"
+	eps := NewEndpointDescriptionArray([]*EndpointDescription{
+		NewEndpointDescription("ep-none", nil, nil, SecModeNone, "", nil, TransportProfileURIBinary, 0),
+		NewEndpointDescription("ep-https", nil, nil, SecModeSign, "", nil, profileHTTPS, 2),
+		NewEndpointDescription("ep-sign-encrypt", nil, nil, SecModeSignAndEncrypt, "", nil, TransportProfileURIBinary, 3),
+		NewEndpointDescription("ep-sign", nil, nil, SecModeSign, "", nil, TransportProfileURIBinary, 2),
+	})
+
+	var cases = []struct {
+		description string
+		profileURIs []string
+		expected    []string
+	}{
+		{"binary", []string{TransportProfileURIBinary}, []string{"ep-sign-encrypt", "ep-sign", "ep-none"}},
+		{"https", []string{profileHTTPS}, []string{"ep-https"}},
+		{"all", nil, []string{"ep-sign-encrypt", "ep-https", "ep-sign", "ep-none"}},
+		{"no-match", []string{"unknown"}, nil},
+	}
+	for _, c := range cases {
+		t.Run(c.description, func(t *testing.T) {
+			filtered := eps.Filter(c.profileURIs...)
+			if got := int(filtered.ArraySize); got != len(c.expected) {
+				t.Errorf("ArraySize doesn't match. Want: %d, Got: %d", len(c.expected), got)
+			}
+
+			for i, ep := range filtered.EndpointDescriptions {
+				if i >= len(c.expected) {
+					break
+				}
+				if got := ep.EndpointURL.Get(); got != c.expected[i] {
+					t.Errorf("EndpointURL doesn't match at %d. Want: %s, Got: %s", i, c.expected[i], got)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,405 @@
+// Copyright 2018 gopcua authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package services
+
+import (
+	"encoding/binary"
+
+	"github.com/wmnsk/gopcua/datatypes"
+	"github.com/wmnsk/gopcua/errors"
+	"github.com/wmnsk/gopcua/id"
+)
+
+func init() {
+	datatypes.RegisterExtensionObject(
+		datatypes.NewFourByteNodeID(0, id.EventFilter_Encoding_DefaultBinary),
+		func() datatypes.Data { return &EventFilter{} },
+	)
+}
+
+// SimpleAttributeOperand is a simplified form of AttributeOperand, which selects
+// an Attribute of a Node by the TypeDefinition and the path of BrowseNames from it.
+// It is used in the SelectClauses of EventFilter.
+//
+// Specification: Part 4, 7.4.4.5
+type SimpleAttributeOperand struct {
+	TypeDefinitionID datatypes.NodeID
+	BrowsePath       *datatypes.QualifiedNameArray
+	AttributeID      datatypes.IntegerID
+	IndexRange       *datatypes.String
+}
+
+// NewSimpleAttributeOperand creates a new SimpleAttributeOperand.
+func NewSimpleAttributeOperand(typeDef datatypes.NodeID, path []*datatypes.QualifiedName, attrID datatypes.IntegerID, indexRange string) *SimpleAttributeOperand {
+	return &SimpleAttributeOperand{
+		TypeDefinitionID: typeDef,
+		BrowsePath:       datatypes.NewQualifiedNameArray(path),
+		AttributeID:      attrID,
+		IndexRange:       datatypes.NewString(indexRange),
+	}
+}
+
+// DecodeSimpleAttributeOperand decodes given bytes into SimpleAttributeOperand.
+func DecodeSimpleAttributeOperand(b []byte) (*SimpleAttributeOperand, error) {
+	s := &SimpleAttributeOperand{}
+	if err := s.DecodeFromBytes(b); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// DecodeFromBytes decodes given bytes into SimpleAttributeOperand.
+func (s *SimpleAttributeOperand) DecodeFromBytes(b []byte) error {
+	typeDef, err := datatypes.DecodeNodeID(b)
+	if err != nil {
+		return err
+	}
+	s.TypeDefinitionID = typeDef
+	offset := s.TypeDefinitionID.Len()
+
+	s.BrowsePath = &datatypes.QualifiedNameArray{}
+	if err := s.BrowsePath.DecodeFromBytes(b[offset:]); err != nil {
+		return err
+	}
+	offset += s.BrowsePath.Len()
+
+	s.AttributeID = datatypes.IntegerID(binary.LittleEndian.Uint32(b[offset : offset+4]))
+	offset += 4
+
+	s.IndexRange = &datatypes.String{}
+	return s.IndexRange.DecodeFromBytes(b[offset:])
+}
+
+// Serialize serializes SimpleAttributeOperand into bytes.
+func (s *SimpleAttributeOperand) Serialize() ([]byte, error) {
+	b := make([]byte, s.Len())
+	if err := s.SerializeTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// SerializeTo serializes SimpleAttributeOperand into bytes.
+func (s *SimpleAttributeOperand) SerializeTo(b []byte) error {
+	offset := 0
+	if s.TypeDefinitionID != nil {
+		if err := s.TypeDefinitionID.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += s.TypeDefinitionID.Len()
+	}
+
+	if s.BrowsePath != nil {
+		if err := s.BrowsePath.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += s.BrowsePath.Len()
+	}
+
+	binary.LittleEndian.PutUint32(b[offset:offset+4], uint32(s.AttributeID))
+	offset += 4
+
+	if s.IndexRange != nil {
+		if err := datatypes.ValidateIndexRange(s.IndexRange.Get()); err != nil {
+			return err
+		}
+		return s.IndexRange.SerializeTo(b[offset:])
+	}
+
+	return nil
+}
+
+// Len returns the actual length of SimpleAttributeOperand in int.
+func (s *SimpleAttributeOperand) Len() int {
+	// AttributeID
+	l := 4
+	if s.TypeDefinitionID != nil {
+		l += s.TypeDefinitionID.Len()
+	}
+	if s.BrowsePath != nil {
+		l += s.BrowsePath.Len()
+	}
+	if s.IndexRange != nil {
+		l += s.IndexRange.Len()
+	}
+
+	return l
+}
+
+// SimpleAttributeOperandArray represents an array of SimpleAttributeOperands.
+// It does not correspond to a certain type from the specification
+// but makes encoding and decoding easier.
+type SimpleAttributeOperandArray struct {
+	ArraySize int32
+	Operands  []*SimpleAttributeOperand
+}
+
+// NewSimpleAttributeOperandArray creates a new SimpleAttributeOperandArray from multiple SimpleAttributeOperands.
+func NewSimpleAttributeOperandArray(operands []*SimpleAttributeOperand) *SimpleAttributeOperandArray {
+	if operands == nil {
+		return &SimpleAttributeOperandArray{
+			ArraySize: 0,
+		}
+	}
+
+	return &SimpleAttributeOperandArray{
+		ArraySize: int32(len(operands)),
+		Operands:  operands,
+	}
+}
+
+// DecodeSimpleAttributeOperandArray decodes given bytes into SimpleAttributeOperandArray.
+func DecodeSimpleAttributeOperandArray(b []byte) (*SimpleAttributeOperandArray, error) {
+	s := &SimpleAttributeOperandArray{}
+	if err := s.DecodeFromBytes(b); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// DecodeFromBytes decodes given bytes into SimpleAttributeOperandArray.
+func (s *SimpleAttributeOperandArray) DecodeFromBytes(b []byte) error {
+	if len(b) < 4 {
+		return errors.NewErrTooShortToDecode(s, "should be longer than 4 bytes")
+	}
+
+	s.ArraySize = int32(binary.LittleEndian.Uint32(b[:4]))
+	if s.ArraySize <= 0 {
+		return nil
+	}
+
+	offset := 4
+	for i := 1; i <= int(s.ArraySize); i++ {
+		simpleAttributeOperand, err := DecodeSimpleAttributeOperand(b[offset:])
+		if err != nil {
+			return err
+		}
+		s.Operands = append(s.Operands, simpleAttributeOperand)
+		offset += simpleAttributeOperand.Len()
+	}
+
+	return nil
+}
+
+// Serialize serializes SimpleAttributeOperandArray into bytes.
+func (s *SimpleAttributeOperandArray) Serialize() ([]byte, error) {
+	b := make([]byte, s.Len())
+	if err := s.SerializeTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// SerializeTo serializes SimpleAttributeOperandArray into bytes.
+func (s *SimpleAttributeOperandArray) SerializeTo(b []byte) error {
+	offset := 4
+	binary.LittleEndian.PutUint32(b[:4], uint32(s.ArraySize))
+
+	for _, simpleAttributeOperand := range s.Operands {
+		if err := simpleAttributeOperand.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += simpleAttributeOperand.Len()
+	}
+
+	return nil
+}
+
+// Len returns the actual length of SimpleAttributeOperandArray in int.
+func (s *SimpleAttributeOperandArray) Len() int {
+	l := 4
+	for _, simpleAttributeOperand := range s.Operands {
+		l += simpleAttributeOperand.Len()
+	}
+
+	return l
+}
+
+// ContentFilter defines a collection of elements that define filtering criteria.
+//
+// Currently only the empty ContentFilter, which means no filtering, is supported.
+//
+// Specification: Part 4, 7.4.1
+type ContentFilter struct {
+	ArraySize int32
+}
+
+// NewContentFilter creates a new empty ContentFilter.
+func NewContentFilter() *ContentFilter {
+	return &ContentFilter{
+		ArraySize: 0,
+	}
+}
+
+// DecodeContentFilter decodes given bytes into ContentFilter.
+func DecodeContentFilter(b []byte) (*ContentFilter, error) {
+	c := &ContentFilter{}
+	if err := c.DecodeFromBytes(b); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// DecodeFromBytes decodes given bytes into ContentFilter.
+func (c *ContentFilter) DecodeFromBytes(b []byte) error {
+	if len(b) < 4 {
+		return errors.NewErrTooShortToDecode(c, "should be longer than 4 bytes")
+	}
+
+	c.ArraySize = int32(binary.LittleEndian.Uint32(b[:4]))
+	if c.ArraySize > 0 {
+		return errors.NewErrUnsupported(c, "ContentFilterElements are not supported yet.")
+	}
+
+	return nil
+}
+
+// Serialize serializes ContentFilter into bytes.
+func (c *ContentFilter) Serialize() ([]byte, error) {
+	b := make([]byte, c.Len())
+	if err := c.SerializeTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// SerializeTo serializes ContentFilter into bytes.
+func (c *ContentFilter) SerializeTo(b []byte) error {
+	binary.LittleEndian.PutUint32(b[:4], uint32(c.ArraySize))
+	return nil
+}
+
+// Len returns the actual length of ContentFilter in int.
+func (c *ContentFilter) Len() int {
+	return 4
+}
+
+// EventFilter is used as the filter of MonitoringParameters to select the fields
+// of the Events to be returned. It is sent as the body of an ExtensionObject, which
+// is created by ExtensionObject method.
+//
+// Specification: Part 4, 7.17.3
+type EventFilter struct {
+	SelectClauses *SimpleAttributeOperandArray
+	WhereClause   *ContentFilter
+}
+
+// NewEventFilter creates a new EventFilter which selects the Value of the fields given
+// in the text format of RelativePath from BaseEventType, e.g. "Message" or "0:Severity".
+//
+// The Events are not filtered, as the WhereClause is always empty.
+func NewEventFilter(fields ...string) (*EventFilter, error) {
+	var clauses []*SimpleAttributeOperand
+	for _, f := range fields {
+		path, err := ParseRelativePath(f)
+		if err != nil {
+			return nil, err
+		}
+
+		var names []*datatypes.QualifiedName
+		for _, elem := range path.Elements {
+			names = append(names, elem.TargetName)
+		}
+		clauses = append(clauses, NewSimpleAttributeOperand(
+			datatypes.NewFourByteNodeID(0, id.BaseEventType),
+			names, datatypes.IntegerIDValue, "",
+		))
+	}
+
+	return &EventFilter{
+		SelectClauses: NewSimpleAttributeOperandArray(clauses),
+		WhereClause:   NewContentFilter(),
+	}, nil
+}
+
+// DecodeEventFilter decodes given bytes into EventFilter.
+func DecodeEventFilter(b []byte) (*EventFilter, error) {
+	e := &EventFilter{}
+	if err := e.DecodeFromBytes(b); err != nil {
+		return nil, err
+	}
+
+	return e, nil
+}
+
+// DecodeFromBytes decodes given bytes into EventFilter.
+func (e *EventFilter) DecodeFromBytes(b []byte) error {
+	e.SelectClauses = &SimpleAttributeOperandArray{}
+	if err := e.SelectClauses.DecodeFromBytes(b); err != nil {
+		return err
+	}
+	offset := e.SelectClauses.Len()
+
+	e.WhereClause = &ContentFilter{}
+	return e.WhereClause.DecodeFromBytes(b[offset:])
+}
+
+// Serialize serializes EventFilter into bytes.
+func (e *EventFilter) Serialize() ([]byte, error) {
+	b := make([]byte, e.Len())
+	if err := e.SerializeTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// SerializeTo serializes EventFilter into bytes.
+func (e *EventFilter) SerializeTo(b []byte) error {
+	offset := 0
+	if e.SelectClauses != nil {
+		if err := e.SelectClauses.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += e.SelectClauses.Len()
+	}
+
+	if e.WhereClause != nil {
+		return e.WhereClause.SerializeTo(b[offset:])
+	}
+
+	return nil
+}
+
+// Len returns the actual length of EventFilter in int.
+func (e *EventFilter) Len() int {
+	l := 0
+	if e.SelectClauses != nil {
+		l += e.SelectClauses.Len()
+	}
+	if e.WhereClause != nil {
+		l += e.WhereClause.Len()
+	}
+
+	return l
+}
+
+// DataType returns type of Data.
+func (e *EventFilter) DataType() uint16 {
+	return id.Structure
+}
+
+// ExtensionObject returns EventFilter in ExtensionObject, which can be used as
+// the Filter in MonitoringParameters.
+func (e *EventFilter) ExtensionObject() *datatypes.ExtensionObject {
+	eo := &datatypes.ExtensionObject{
+		TypeID: datatypes.NewExpandedNodeID(
+			false, false,
+			datatypes.NewFourByteNodeID(0, id.EventFilter_Encoding_DefaultBinary),
+			"", 0,
+		),
+		EncodingMask: 0x01,
+		Value:        e,
+	}
+	eo.SetLength()
+
+	return eo
+}
@@ -0,0 +1,109 @@
+// Copyright 2018 gopcua authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package services
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/wmnsk/gopcua/datatypes"
+)
+
+var eventFilterBytes = []byte{
+	// SelectClauses
+	// ArraySize
+	0x02, 0x00, 0x00, 0x00,
+	// TypeDefinitionID
+	0x01, 0x00, 0xf9, 0x07,
+	// BrowsePath
+	0x01, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x07, 0x00, 0x00, 0x00, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65,
+	// AttributeID
+	0x0d, 0x00, 0x00, 0x00,
+	// IndexRange
+	0xff, 0xff, 0xff, 0xff,
+	// TypeDefinitionID
+	0x01, 0x00, 0xf9, 0x07,
+	// BrowsePath
+	0x01, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x08, 0x00, 0x00, 0x00, 0x53, 0x65,
+	0x76, 0x65, 0x72, 0x69, 0x74, 0x79,
+	// AttributeID
+	0x0d, 0x00, 0x00, 0x00,
+	// IndexRange
+	0xff, 0xff, 0xff, 0xff,
+	// WhereClause
+	0x00, 0x00, 0x00, 0x00,
+}
+
+func TestNewEventFilter(t *testing.T) {
+	f, err := NewEventFilter("Message", "0:Severity")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("serialize", func(t *testing.T) {
+		b, err := f.Serialize()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(b, eventFilterBytes); diff != "" {
+			t.Error(diff)
+		}
+	})
+	t.Run("decode", func(t *testing.T) {
+		got, err := DecodeEventFilter(eventFilterBytes)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, f, decodeCmpOpt); diff != "" {
+			t.Error(diff)
+		}
+	})
+	t.Run("len", func(t *testing.T) {
+		if f.Len() != len(eventFilterBytes) {
+			t.Errorf("Len doesn't match. Want: %d, Got: %d", len(eventFilterBytes), f.Len())
+		}
+	})
+	t.Run("extension-object", func(t *testing.T) {
+		eo := f.ExtensionObject()
+		b, err := eo.Serialize()
+		if err != nil {
+			t.Fatal(err)
+		}
+		header := []byte{
+			// TypeID
+			0x01, 0x00, 0xd7, 0x02,
+			// EncodingMask
+			0x01,
+			// Length
+			0x43, 0x00, 0x00, 0x00,
+		}
+		if diff := cmp.Diff(b, append(header, eventFilterBytes...)); diff != "" {
+			t.Error(diff)
+		}
+
+		got, err := datatypes.DecodeExtensionObject(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got.Value, f, decodeCmpOpt); diff != "" {
+			t.Error(diff)
+		}
+	})
+	t.Run("invalid-field", func(t *testing.T) {
+		if _, err := NewEventFilter("Message", ""); err == nil {
+			t.Error("expected error")
+		}
+	})
+}
+
+func TestDecodeContentFilterUnsupported(t *testing.T) {
+	if _, err := DecodeContentFilter([]byte{0x01, 0x00, 0x00, 0x00}); err == nil {
+		t.Error("expected error")
+	}
+}
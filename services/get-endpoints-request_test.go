@@ -0,0 +1,153 @@
+// Copyright 2018 gopcua authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+var getEndpointsRequestCases = []struct {
+	description string
+	structured  *GetEndpointsRequest
+	serialized  []byte
+}{
+	{
+		"no-locale-no-profile",
+		NewGetEndpointsRequest(
+			time.Date(2018, time.August, 10, 23, 0, 0, 0, time.UTC),
+			1, 0, 0, "", "opc.tcp://localhost:4840", nil, nil,
+		),
+		[]byte{ // GetEndpointsRequest
+			// TypeID
+			0x01, 0x00, 0xac, 0x01,
+			// RequestHeader
+			// AuthenticationToken
+			0x00, 0x00,
+			// Timestamp
+			0x00, 0x98, 0x67, 0xdd, 0xfd, 0x30, 0xd4, 0x01,
+			// RequestHandle
+			0x01, 0x00, 0x00, 0x00,
+			// ReturnDiagnostics
+			0x00, 0x00, 0x00, 0x00,
+			// AuditEntryID
+			0xff, 0xff, 0xff, 0xff,
+			// TimeoutHint
+			0x00, 0x00, 0x00, 0x00,
+			// AdditionalHeader
+			0x00, 0x00, 0x00,
+			// EndpointURL
+			0x18, 0x00, 0x00, 0x00, 0x6f, 0x70, 0x63, 0x2e,
+			0x74, 0x63, 0x70, 0x3a, 0x2f, 0x2f, 0x6c, 0x6f,
+			0x63, 0x61, 0x6c, 0x68, 0x6f, 0x73, 0x74, 0x3a,
+			0x34, 0x38, 0x34, 0x30,
+			// LocaleIDs
+			0x00, 0x00, 0x00, 0x00,
+			// ProfileURIs
+			0x00, 0x00, 0x00, 0x00,
+		},
+	},
+	{
+		"with-locales-and-profile",
+		NewGetEndpointsRequest(
+			time.Date(2018, time.August, 10, 23, 0, 0, 0, time.UTC),
+			1, 0, 0, "", "opc.tcp://localhost:4840",
+			[]string{"en-US", "ja-JP"},
+			[]string{"http://opcfoundation.org/UA-Profile/Transport/uatcp-uasc-uabinary"},
+		),
+		[]byte{ // GetEndpointsRequest
+			// TypeID
+			0x01, 0x00, 0xac, 0x01,
+			// RequestHeader
+			// AuthenticationToken
+			0x00, 0x00,
+			// Timestamp
+			0x00, 0x98, 0x67, 0xdd, 0xfd, 0x30, 0xd4, 0x01,
+			// RequestHandle
+			0x01, 0x00, 0x00, 0x00,
+			// ReturnDiagnostics
+			0x00, 0x00, 0x00, 0x00,
+			// AuditEntryID
+			0xff, 0xff, 0xff, 0xff,
+			// TimeoutHint
+			0x00, 0x00, 0x00, 0x00,
+			// AdditionalHeader
+			0x00, 0x00, 0x00,
+			// EndpointURL
+			0x18, 0x00, 0x00, 0x00, 0x6f, 0x70, 0x63, 0x2e,
+			0x74, 0x63, 0x70, 0x3a, 0x2f, 0x2f, 0x6c, 0x6f,
+			0x63, 0x61, 0x6c, 0x68, 0x6f, 0x73, 0x74, 0x3a,
+			0x34, 0x38, 0x34, 0x30,
+			// LocaleIDs
+			0x02, 0x00, 0x00, 0x00,
+			0x05, 0x00, 0x00, 0x00, 0x65, 0x6e, 0x2d, 0x55, 0x53,
+			0x05, 0x00, 0x00, 0x00, 0x6a, 0x61, 0x2d, 0x4a, 0x50,
+			// ProfileURIs
+			0x01, 0x00, 0x00, 0x00,
+			0x41, 0x00, 0x00, 0x00, 0x68, 0x74, 0x74, 0x70,
+			0x3a, 0x2f, 0x2f, 0x6f, 0x70, 0x63, 0x66, 0x6f,
+			0x75, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+			0x2e, 0x6f, 0x72, 0x67, 0x2f, 0x55, 0x41, 0x2d,
+			0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2f,
+			0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72,
+			0x74, 0x2f, 0x75, 0x61, 0x74, 0x63, 0x70, 0x2d,
+			0x75, 0x61, 0x73, 0x63, 0x2d, 0x75, 0x61, 0x62,
+			0x69, 0x6e, 0x61, 0x72, 0x79,
+		},
+	},
+}
+
+func TestDecodeGetEndpointsRequest(t *testing.T) {
+	for _, c := range getEndpointsRequestCases {
+		got, err := DecodeGetEndpointsRequest(c.serialized)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// need to clear Payload here.
+		got.Payload = nil
+
+		if diff := cmp.Diff(got, c.structured, decodeCmpOpt); diff != "" {
+			t.Errorf("%s failed\n%s", c.description, diff)
+		}
+	}
+}
+
+func TestSerializeGetEndpointsRequest(t *testing.T) {
+	for _, c := range getEndpointsRequestCases {
+		got, err := c.structured.Serialize()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if diff := cmp.Diff(got, c.serialized); diff != "" {
+			t.Errorf("%s failed\n%s", c.description, diff)
+		}
+	}
+}
+
+func TestGetEndpointsRequestLen(t *testing.T) {
+	for _, c := range getEndpointsRequestCases {
+		got := c.structured.Len()
+
+		if diff := cmp.Diff(got, len(c.serialized)); diff != "" {
+			t.Errorf("%s failed\n%s", c.description, diff)
+		}
+	}
+}
+
+func TestGetEndpointsRequestServiceType(t *testing.T) {
+	for _, c := range getEndpointsRequestCases {
+		if c.structured.ServiceType() != ServiceTypeGetEndpointsRequest {
+			t.Errorf(
+				"ServiceType doesn't match. Want: %d, Got: %d",
+				ServiceTypeGetEndpointsRequest,
+				c.structured.ServiceType(),
+			)
+		}
+	}
+}
@@ -0,0 +1,98 @@
+// Copyright 2018 gopcua authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package services
+
+import (
+	"github.com/wmnsk/gopcua/datatypes"
+	"github.com/wmnsk/gopcua/id"
+)
+
+func init() {
+	datatypes.RegisterExtensionObject(
+		datatypes.NewFourByteNodeID(0, id.HistoryData_Encoding_DefaultBinary),
+		func() datatypes.Data { return &HistoryData{} },
+	)
+}
+
+// HistoryData contains the historical values of a Node returned in HistoryReadResult.
+// It is sent as the body of an ExtensionObject, which is created by ExtensionObject method.
+//
+// Specification: Part 11, 6.5.2
+type HistoryData struct {
+	DataValues *datatypes.DataValueArray
+}
+
+// NewHistoryData creates a new HistoryData.
+func NewHistoryData(values []*datatypes.DataValue) *HistoryData {
+	return &HistoryData{
+		DataValues: datatypes.NewDataValueArray(values),
+	}
+}
+
+// DecodeHistoryData decodes given bytes into HistoryData.
+func DecodeHistoryData(b []byte) (*HistoryData, error) {
+	h := &HistoryData{}
+	if err := h.DecodeFromBytes(b); err != nil {
+		return nil, err
+	}
+
+	return h, nil
+}
+
+// DecodeFromBytes decodes given bytes into HistoryData.
+func (h *HistoryData) DecodeFromBytes(b []byte) error {
+	h.DataValues = &datatypes.DataValueArray{}
+	return h.DataValues.DecodeFromBytes(b)
+}
+
+// Serialize serializes HistoryData into bytes.
+func (h *HistoryData) Serialize() ([]byte, error) {
+	b := make([]byte, h.Len())
+	if err := h.SerializeTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// SerializeTo serializes HistoryData into bytes.
+func (h *HistoryData) SerializeTo(b []byte) error {
+	if h.DataValues != nil {
+		return h.DataValues.SerializeTo(b)
+	}
+
+	return nil
+}
+
+// Len returns the actual length of HistoryData in int.
+func (h *HistoryData) Len() int {
+	if h.DataValues != nil {
+		return h.DataValues.Len()
+	}
+
+	return 0
+}
+
+// DataType returns type of Data.
+func (h *HistoryData) DataType() uint16 {
+	return id.Structure
+}
+
+// ExtensionObject returns HistoryData in ExtensionObject, which can be used as
+// HistoryData in HistoryReadResult.
+func (h *HistoryData) ExtensionObject() *datatypes.ExtensionObject {
+	e := &datatypes.ExtensionObject{
+		TypeID: datatypes.NewExpandedNodeID(
+			false, false,
+			datatypes.NewFourByteNodeID(0, id.HistoryData_Encoding_DefaultBinary),
+			"", 0,
+		),
+		EncodingMask: 0x01,
+		Value:        h,
+	}
+	e.SetLength()
+
+	return e
+}
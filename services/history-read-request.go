@@ -0,0 +1,197 @@
+// Copyright 2018 gopcua authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package services
+
+import (
+	"encoding/binary"
+	"time"
+
+	"github.com/wmnsk/gopcua/datatypes"
+	"github.com/wmnsk/gopcua/errors"
+)
+
+// HistoryReadRequest is used to read historical values or Events of one or more Nodes.
+//
+// Only ReadRawModifiedDetails is supported as HistoryReadDetails for now, which is
+// decoded into the Value of HistoryReadDetails.
+//
+// Specification: Part 4, 5.10.3.2
+type HistoryReadRequest struct {
+	TypeID *datatypes.ExpandedNodeID
+	*RequestHeader
+
+	// The details define the type of history read to be performed.
+	HistoryReadDetails *datatypes.ExtensionObject
+
+	// An enumeration that specifies the timestamps to be returned for each requested
+	// Variable Value Attribute. TimestampsToReturnNeither is not valid for HistoryRead.
+	TimestampsToReturn TimestampsToReturn
+
+	// If true, passed ContinuationPoints shall be reset to free resources in the Server
+	// and no data is returned.
+	ReleaseContinuationPoints *datatypes.Boolean
+
+	NodesToRead *HistoryReadValueIDArray
+}
+
+// NewHistoryReadRequest creates a new HistoryReadRequest.
+func NewHistoryReadRequest(ts time.Time, authToken datatypes.NodeID, handle, diag, timeout uint32, auditID string, details *datatypes.ExtensionObject, tsRet TimestampsToReturn, release bool, nodes []*HistoryReadValueID) *HistoryReadRequest {
+	return &HistoryReadRequest{
+		TypeID: datatypes.NewExpandedNodeID(
+			false, false,
+			datatypes.NewFourByteNodeID(
+				0, ServiceTypeHistoryReadRequest,
+			),
+			"", 0,
+		),
+		RequestHeader: NewRequestHeader(
+			authToken,
+			ts,
+			handle,
+			diag,
+			timeout,
+			auditID,
+			NewAdditionalHeader(
+				datatypes.NewExpandedNodeID(
+					false, false,
+					datatypes.NewTwoByteNodeID(0),
+					"", 0,
+				),
+				0x00,
+			),
+			nil,
+		),
+		HistoryReadDetails:        details,
+		TimestampsToReturn:        tsRet,
+		ReleaseContinuationPoints: datatypes.NewBoolean(release),
+		NodesToRead:               NewHistoryReadValueIDArray(nodes),
+	}
+}
+
+// DecodeHistoryReadRequest decodes given bytes into HistoryReadRequest.
+func DecodeHistoryReadRequest(b []byte) (*HistoryReadRequest, error) {
+	h := &HistoryReadRequest{}
+	if err := h.DecodeFromBytes(b); err != nil {
+		return nil, err
+	}
+
+	return h, nil
+}
+
+// DecodeFromBytes decodes given bytes into HistoryReadRequest.
+func (h *HistoryReadRequest) DecodeFromBytes(b []byte) error {
+	offset := 0
+	h.TypeID = &datatypes.ExpandedNodeID{}
+	if err := h.TypeID.DecodeFromBytes(b[offset:]); err != nil {
+		return err
+	}
+	offset += h.TypeID.Len()
+
+	h.RequestHeader = &RequestHeader{}
+	if err := h.RequestHeader.DecodeFromBytes(b[offset:]); err != nil {
+		return err
+	}
+	offset += h.RequestHeader.Len() - len(h.RequestHeader.Payload)
+
+	h.HistoryReadDetails = &datatypes.ExtensionObject{}
+	if err := h.HistoryReadDetails.DecodeFromBytes(b[offset:]); err != nil {
+		return err
+	}
+	offset += h.HistoryReadDetails.Len()
+
+	if len(b[offset:]) < 5 {
+		return errors.NewErrTooShortToDecode(h, "should have TimestampsToReturn and ReleaseContinuationPoints")
+	}
+	h.TimestampsToReturn = TimestampsToReturn(binary.LittleEndian.Uint32(b[offset : offset+4]))
+	offset += 4
+
+	h.ReleaseContinuationPoints = &datatypes.Boolean{}
+	if err := h.ReleaseContinuationPoints.DecodeFromBytes(b[offset:]); err != nil {
+		return err
+	}
+	offset += h.ReleaseContinuationPoints.Len()
+
+	h.NodesToRead = &HistoryReadValueIDArray{}
+	return h.NodesToRead.DecodeFromBytes(b[offset:])
+}
+
+// Serialize serializes HistoryReadRequest into bytes.
+func (h *HistoryReadRequest) Serialize() ([]byte, error) {
+	b := make([]byte, h.Len())
+	if err := h.SerializeTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// SerializeTo serializes HistoryReadRequest into bytes.
+func (h *HistoryReadRequest) SerializeTo(b []byte) error {
+	offset := 0
+	if h.TypeID != nil {
+		if err := h.TypeID.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += h.TypeID.Len()
+	}
+
+	if h.RequestHeader != nil {
+		if err := h.RequestHeader.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += h.RequestHeader.Len() - len(h.Payload)
+	}
+
+	if h.HistoryReadDetails != nil {
+		if err := h.HistoryReadDetails.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += h.HistoryReadDetails.Len()
+	}
+
+	binary.LittleEndian.PutUint32(b[offset:offset+4], uint32(h.TimestampsToReturn))
+	offset += 4
+
+	if h.ReleaseContinuationPoints != nil {
+		if err := h.ReleaseContinuationPoints.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += h.ReleaseContinuationPoints.Len()
+	}
+
+	if h.NodesToRead != nil {
+		return h.NodesToRead.SerializeTo(b[offset:])
+	}
+
+	return nil
+}
+
+// Len returns the actual length of HistoryReadRequest.
+func (h *HistoryReadRequest) Len() int {
+	// TimestampsToReturn
+	l := 4
+	if h.TypeID != nil {
+		l += h.TypeID.Len()
+	}
+	if h.RequestHeader != nil {
+		l += (h.RequestHeader.Len() - len(h.Payload))
+	}
+	if h.HistoryReadDetails != nil {
+		l += h.HistoryReadDetails.Len()
+	}
+	if h.ReleaseContinuationPoints != nil {
+		l += h.ReleaseContinuationPoints.Len()
+	}
+	if h.NodesToRead != nil {
+		l += h.NodesToRead.Len()
+	}
+
+	return l
+}
+
+// ServiceType returns type of Service in uint16.
+func (h *HistoryReadRequest) ServiceType() uint16 {
+	return ServiceTypeHistoryReadRequest
+}
@@ -0,0 +1,141 @@
+// Copyright 2018 gopcua authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/wmnsk/gopcua/datatypes"
+)
+
+var historyReadRequestCases = []struct {
+	description string
+	structured  *HistoryReadRequest
+	serialized  []byte
+}{
+	{
+		"read-raw",
+		NewHistoryReadRequest(
+			time.Date(2018, time.August, 10, 23, 0, 0, 0, time.UTC),
+			datatypes.NewTwoByteNodeID(0), 1, 0, 0, "",
+			NewReadRawModifiedDetails(
+				false,
+				time.Date(2018, time.August, 10, 23, 0, 0, 0, time.UTC),
+				time.Date(2018, time.August, 11, 0, 0, 0, 0, time.UTC),
+				100, false,
+			).ExtensionObject(),
+			TimestampsToReturnBoth, false,
+			[]*HistoryReadValueID{
+				NewHistoryReadValueID(
+					datatypes.NewFourByteNodeID(2, 10), "", 0, "", nil,
+				),
+			},
+		),
+		[]byte{ // HistoryReadRequest
+			// TypeID
+			0x01, 0x00, 0x98, 0x02,
+			// RequestHeader
+			// AuthenticationToken
+			0x00, 0x00,
+			// Timestamp
+			0x00, 0x98, 0x67, 0xdd, 0xfd, 0x30, 0xd4, 0x01,
+			// RequestHandle
+			0x01, 0x00, 0x00, 0x00,
+			// ReturnDiagnostics
+			0x00, 0x00, 0x00, 0x00,
+			// AuditEntryID
+			0xff, 0xff, 0xff, 0xff,
+			// TimeoutHint
+			0x00, 0x00, 0x00, 0x00,
+			// AdditionalHeader
+			0x00, 0x00, 0x00,
+			// HistoryReadDetails
+			// TypeID
+			0x01, 0x00, 0x89, 0x02,
+			// EncodingMask
+			0x01,
+			// Length
+			0x16, 0x00, 0x00, 0x00,
+			// IsReadModified
+			0x00,
+			// StartTime
+			0x00, 0x98, 0x67, 0xdd, 0xfd, 0x30, 0xd4, 0x01,
+			// EndTime
+			0x00, 0x00, 0x2c, 0x3f, 0x06, 0x31, 0xd4, 0x01,
+			// NumValuesPerNode
+			0x64, 0x00, 0x00, 0x00,
+			// ReturnBounds
+			0x00,
+			// TimestampsToReturn
+			0x02, 0x00, 0x00, 0x00,
+			// ReleaseContinuationPoints
+			0x00,
+			// NodesToRead: ArraySize
+			0x01, 0x00, 0x00, 0x00,
+			// NodeID
+			0x01, 0x02, 0x0a, 0x00,
+			// IndexRange
+			0xff, 0xff, 0xff, 0xff,
+			// DataEncoding
+			0x00, 0x00, 0xff, 0xff, 0xff, 0xff,
+			// ContinuationPoint
+			0xff, 0xff, 0xff, 0xff,
+		},
+	},
+}
+
+func TestDecodeHistoryReadRequest(t *testing.T) {
+	for _, c := range historyReadRequestCases {
+		got, err := DecodeHistoryReadRequest(c.serialized)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// need to clear Payload here.
+		got.Payload = nil
+
+		if diff := cmp.Diff(got, c.structured, decodeCmpOpt); diff != "" {
+			t.Errorf("%s failed\n%s", c.description, diff)
+		}
+	}
+}
+
+func TestSerializeHistoryReadRequest(t *testing.T) {
+	for _, c := range historyReadRequestCases {
+		got, err := c.structured.Serialize()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if diff := cmp.Diff(got, c.serialized); diff != "" {
+			t.Errorf("%s failed\n%s", c.description, diff)
+		}
+	}
+}
+
+func TestHistoryReadRequestLen(t *testing.T) {
+	for _, c := range historyReadRequestCases {
+		got := c.structured.Len()
+
+		if diff := cmp.Diff(got, len(c.serialized)); diff != "" {
+			t.Errorf("%s failed\n%s", c.description, diff)
+		}
+	}
+}
+
+func TestHistoryReadRequestServiceType(t *testing.T) {
+	for _, c := range historyReadRequestCases {
+		if c.structured.ServiceType() != ServiceTypeHistoryReadRequest {
+			t.Errorf(
+				"ServiceType doesn't match. Want: %d, Got: %d",
+				ServiceTypeHistoryReadRequest,
+				c.structured.ServiceType(),
+			)
+		}
+	}
+}
@@ -0,0 +1,153 @@
+// Copyright 2018 gopcua authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package services
+
+import (
+	"time"
+
+	"github.com/wmnsk/gopcua/datatypes"
+)
+
+// HistoryReadResponse represents the response to a HistoryReadRequest.
+// The Results are in the same order as the NodesToRead in the request.
+//
+// Specification: Part 4, 5.10.3.2
+type HistoryReadResponse struct {
+	TypeID *datatypes.ExpandedNodeID
+	*ResponseHeader
+	Results         *HistoryReadResultArray
+	DiagnosticInfos *DiagnosticInfoArray
+}
+
+// NewHistoryReadResponse creates a new HistoryReadResponse.
+func NewHistoryReadResponse(ts time.Time, handle, code uint32, diag *DiagnosticInfo, strs []string, results []*HistoryReadResult, diags []*DiagnosticInfo) *HistoryReadResponse {
+	return &HistoryReadResponse{
+		TypeID: datatypes.NewExpandedNodeID(
+			false, false,
+			datatypes.NewFourByteNodeID(
+				0, ServiceTypeHistoryReadResponse,
+			),
+			"", 0,
+		),
+		ResponseHeader: NewResponseHeader(
+			ts,
+			handle,
+			code,
+			diag,
+			strs,
+			NewAdditionalHeader(
+				datatypes.NewExpandedNodeID(
+					false, false,
+					datatypes.NewTwoByteNodeID(0),
+					"", 0,
+				),
+				0x00,
+			),
+			nil,
+		),
+		Results:         NewHistoryReadResultArray(results),
+		DiagnosticInfos: NewDiagnosticInfoArray(diags),
+	}
+}
+
+// DecodeHistoryReadResponse decodes given bytes into HistoryReadResponse.
+func DecodeHistoryReadResponse(b []byte) (*HistoryReadResponse, error) {
+	h := &HistoryReadResponse{}
+	if err := h.DecodeFromBytes(b); err != nil {
+		return nil, err
+	}
+
+	return h, nil
+}
+
+// DecodeFromBytes decodes given bytes into HistoryReadResponse.
+func (h *HistoryReadResponse) DecodeFromBytes(b []byte) error {
+	offset := 0
+	h.TypeID = &datatypes.ExpandedNodeID{}
+	if err := h.TypeID.DecodeFromBytes(b[offset:]); err != nil {
+		return err
+	}
+	offset += h.TypeID.Len()
+
+	h.ResponseHeader = &ResponseHeader{}
+	if err := h.ResponseHeader.DecodeFromBytes(b[offset:]); err != nil {
+		return err
+	}
+	offset += h.ResponseHeader.Len() - len(h.ResponseHeader.Payload)
+
+	h.Results = &HistoryReadResultArray{}
+	if err := h.Results.DecodeFromBytes(b[offset:]); err != nil {
+		return err
+	}
+	offset += h.Results.Len()
+
+	h.DiagnosticInfos = &DiagnosticInfoArray{}
+	return h.DiagnosticInfos.DecodeFromBytes(b[offset:])
+}
+
+// Serialize serializes HistoryReadResponse into bytes.
+func (h *HistoryReadResponse) Serialize() ([]byte, error) {
+	b := make([]byte, h.Len())
+	if err := h.SerializeTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// SerializeTo serializes HistoryReadResponse into bytes.
+func (h *HistoryReadResponse) SerializeTo(b []byte) error {
+	offset := 0
+	if h.TypeID != nil {
+		if err := h.TypeID.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += h.TypeID.Len()
+	}
+
+	if h.ResponseHeader != nil {
+		if err := h.ResponseHeader.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += h.ResponseHeader.Len() - len(h.Payload)
+	}
+
+	if h.Results != nil {
+		if err := h.Results.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += h.Results.Len()
+	}
+
+	if h.DiagnosticInfos != nil {
+		return h.DiagnosticInfos.SerializeTo(b[offset:])
+	}
+
+	return nil
+}
+
+// Len returns the actual length of HistoryReadResponse.
+func (h *HistoryReadResponse) Len() int {
+	l := 0
+	if h.TypeID != nil {
+		l += h.TypeID.Len()
+	}
+	if h.ResponseHeader != nil {
+		l += (h.ResponseHeader.Len() - len(h.Payload))
+	}
+	if h.Results != nil {
+		l += h.Results.Len()
+	}
+	if h.DiagnosticInfos != nil {
+		l += h.DiagnosticInfos.Len()
+	}
+
+	return l
+}
+
+// ServiceType returns type of Service in uint16.
+func (h *HistoryReadResponse) ServiceType() uint16 {
+	return ServiceTypeHistoryReadResponse
+}
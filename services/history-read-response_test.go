@@ -0,0 +1,147 @@
+// Copyright 2018 gopcua authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/wmnsk/gopcua/datatypes"
+	"github.com/wmnsk/gopcua/status"
+)
+
+var historyReadResponseCases = []struct {
+	description string
+	structured  *HistoryReadResponse
+	serialized  []byte
+}{
+	{
+		"normal",
+		NewHistoryReadResponse(
+			time.Date(2018, time.August, 10, 23, 0, 0, 0, time.UTC),
+			1, 0, nil, nil,
+			[]*HistoryReadResult{
+				NewHistoryReadResult(
+					0, []byte{0xca, 0xfe},
+					NewHistoryData([]*datatypes.DataValue{
+						{
+							EncodingMask: 0x01,
+							Value:        datatypes.NewVariant(datatypes.NewFloat(2.5)),
+						},
+					}).ExtensionObject(),
+				),
+				NewHistoryReadResult(
+					status.BadNodeIdUnknown, nil, datatypes.NewNullExtensionObject(),
+				),
+			},
+			nil,
+		),
+		[]byte{ // HistoryReadResponse
+			// TypeID
+			0x01, 0x00, 0x9b, 0x02,
+			// ResponseHeader
+			// Timestamp
+			0x00, 0x98, 0x67, 0xdd, 0xfd, 0x30, 0xd4, 0x01,
+			// RequestHandle
+			0x01, 0x00, 0x00, 0x00,
+			// ServiceResult
+			0x00, 0x00, 0x00, 0x00,
+			// ServiceDiagnostics
+			0x00,
+			// StringTable
+			0x00, 0x00, 0x00, 0x00,
+			// AdditionalHeader
+			0x00, 0x00, 0x00,
+			// Results: ArraySize
+			0x02, 0x00, 0x00, 0x00,
+			// StatusCode
+			0x00, 0x00, 0x00, 0x00,
+			// ContinuationPoint
+			0x02, 0x00, 0x00, 0x00, 0xca, 0xfe,
+			// HistoryData: TypeID
+			0x01, 0x00, 0x92, 0x02,
+			// HistoryData: EncodingMask
+			0x01,
+			// HistoryData: Length
+			0x0a, 0x00, 0x00, 0x00,
+			// HistoryData: DataValues
+			0x01, 0x00, 0x00, 0x00,
+			0x01, 0x0a, 0x00, 0x00, 0x20, 0x40,
+			// StatusCode
+			0x00, 0x00, 0x34, 0x80,
+			// ContinuationPoint
+			0xff, 0xff, 0xff, 0xff,
+			// HistoryData
+			0x00, 0x00, 0x00,
+			// DiagnosticInfos
+			0x00, 0x00, 0x00, 0x00,
+		},
+	},
+}
+
+func TestDecodeHistoryReadResponse(t *testing.T) {
+	for _, c := range historyReadResponseCases {
+		got, err := DecodeHistoryReadResponse(c.serialized)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// need to clear Payload here.
+		got.Payload = nil
+
+		if diff := cmp.Diff(got, c.structured, decodeCmpOpt); diff != "" {
+			t.Errorf("%s failed\n%s", c.description, diff)
+		}
+	}
+}
+
+func TestSerializeHistoryReadResponse(t *testing.T) {
+	for _, c := range historyReadResponseCases {
+		got, err := c.structured.Serialize()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if diff := cmp.Diff(got, c.serialized); diff != "" {
+			t.Errorf("%s failed\n%s", c.description, diff)
+		}
+	}
+}
+
+func TestHistoryReadResponseLen(t *testing.T) {
+	for _, c := range historyReadResponseCases {
+		got := c.structured.Len()
+
+		if diff := cmp.Diff(got, len(c.serialized)); diff != "" {
+			t.Errorf("%s failed\n%s", c.description, diff)
+		}
+	}
+}
+
+func TestHistoryReadResponseServiceType(t *testing.T) {
+	for _, c := range historyReadResponseCases {
+		if c.structured.ServiceType() != ServiceTypeHistoryReadResponse {
+			t.Errorf(
+				"ServiceType doesn't match. Want: %d, Got: %d",
+				ServiceTypeHistoryReadResponse,
+				c.structured.ServiceType(),
+			)
+		}
+	}
+}
+
+func TestHistoryReadResultDataValues(t *testing.T) {
+	for _, c := range historyReadResponseCases {
+		results := c.structured.Results.Results
+		if got := len(results[0].DataValues()); got != 1 {
+			t.Errorf("%s failed: got %d values, want 1", c.description, got)
+		}
+		if got := results[1].DataValues(); got != nil {
+			t.Errorf("%s failed: got %v, want nil", c.description, got)
+		}
+	}
+}
@@ -0,0 +1,211 @@
+// Copyright 2018 gopcua authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package services
+
+import (
+	"encoding/binary"
+
+	"github.com/wmnsk/gopcua/datatypes"
+	"github.com/wmnsk/gopcua/errors"
+)
+
+// HistoryReadResult represents the result of HistoryRead for a single Node.
+//
+// Specification: Part 4, 5.10.3.2
+type HistoryReadResult struct {
+	StatusCode uint32
+
+	// This parameter is used only if the number of values to be returned is too large
+	// to be returned in a single response or if the operation was limited to
+	// NumValuesPerNode. When it is not used, the value is null.
+	ContinuationPoint *datatypes.ByteString
+
+	// The body is decoded into HistoryData in the Value. It has no body if the
+	// operation failed, which is what datatypes.NewNullExtensionObject creates.
+	HistoryData *datatypes.ExtensionObject
+}
+
+// NewHistoryReadResult creates a new HistoryReadResult.
+func NewHistoryReadResult(code uint32, cp []byte, data *datatypes.ExtensionObject) *HistoryReadResult {
+	return &HistoryReadResult{
+		StatusCode:        code,
+		ContinuationPoint: datatypes.NewByteString(cp),
+		HistoryData:       data,
+	}
+}
+
+// DecodeHistoryReadResult decodes given bytes into HistoryReadResult.
+func DecodeHistoryReadResult(b []byte) (*HistoryReadResult, error) {
+	h := &HistoryReadResult{}
+	if err := h.DecodeFromBytes(b); err != nil {
+		return nil, err
+	}
+
+	return h, nil
+}
+
+// DecodeFromBytes decodes given bytes into HistoryReadResult.
+func (h *HistoryReadResult) DecodeFromBytes(b []byte) error {
+	if len(b) < 8 {
+		return errors.NewErrTooShortToDecode(h, "should be longer than 8 bytes")
+	}
+	h.StatusCode = binary.LittleEndian.Uint32(b[:4])
+	offset := 4
+
+	h.ContinuationPoint = &datatypes.ByteString{}
+	if err := h.ContinuationPoint.DecodeFromBytes(b[offset:]); err != nil {
+		return err
+	}
+	offset += h.ContinuationPoint.Len()
+
+	h.HistoryData = &datatypes.ExtensionObject{}
+	return h.HistoryData.DecodeFromBytes(b[offset:])
+}
+
+// Serialize serializes HistoryReadResult into bytes.
+func (h *HistoryReadResult) Serialize() ([]byte, error) {
+	b := make([]byte, h.Len())
+	if err := h.SerializeTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// SerializeTo serializes HistoryReadResult into bytes.
+func (h *HistoryReadResult) SerializeTo(b []byte) error {
+	binary.LittleEndian.PutUint32(b[:4], h.StatusCode)
+	offset := 4
+
+	if h.ContinuationPoint != nil {
+		if err := h.ContinuationPoint.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += h.ContinuationPoint.Len()
+	}
+
+	if h.HistoryData != nil {
+		return h.HistoryData.SerializeTo(b[offset:])
+	}
+
+	return nil
+}
+
+// Len returns the actual length of HistoryReadResult in int.
+func (h *HistoryReadResult) Len() int {
+	l := 4
+	if h.ContinuationPoint != nil {
+		l += h.ContinuationPoint.Len()
+	}
+	if h.HistoryData != nil {
+		l += h.HistoryData.Len()
+	}
+
+	return l
+}
+
+// DataValues returns the historical values contained in HistoryReadResult.
+func (h *HistoryReadResult) DataValues() []*datatypes.DataValue {
+	if h.HistoryData == nil {
+		return nil
+	}
+	data, ok := h.HistoryData.Value.(*HistoryData)
+	if !ok || data.DataValues == nil {
+		return nil
+	}
+
+	return data.DataValues.DataValues
+}
+
+// HistoryReadResultArray represents an array of HistoryReadResults.
+// It does not correspond to a certain type from the specification
+// but makes encoding and decoding easier.
+type HistoryReadResultArray struct {
+	ArraySize int32
+	Results   []*HistoryReadResult
+}
+
+// NewHistoryReadResultArray creates a new HistoryReadResultArray from multiple HistoryReadResults.
+func NewHistoryReadResultArray(results []*HistoryReadResult) *HistoryReadResultArray {
+	if results == nil {
+		return &HistoryReadResultArray{
+			ArraySize: 0,
+		}
+	}
+
+	return &HistoryReadResultArray{
+		ArraySize: int32(len(results)),
+		Results:   results,
+	}
+}
+
+// DecodeHistoryReadResultArray decodes given bytes into HistoryReadResultArray.
+func DecodeHistoryReadResultArray(b []byte) (*HistoryReadResultArray, error) {
+	h := &HistoryReadResultArray{}
+	if err := h.DecodeFromBytes(b); err != nil {
+		return nil, err
+	}
+
+	return h, nil
+}
+
+// DecodeFromBytes decodes given bytes into HistoryReadResultArray.
+func (h *HistoryReadResultArray) DecodeFromBytes(b []byte) error {
+	if len(b) < 4 {
+		return errors.NewErrTooShortToDecode(h, "should be longer than 4 bytes")
+	}
+
+	h.ArraySize = int32(binary.LittleEndian.Uint32(b[:4]))
+	if h.ArraySize <= 0 {
+		return nil
+	}
+
+	offset := 4
+	for i := 1; i <= int(h.ArraySize); i++ {
+		result, err := DecodeHistoryReadResult(b[offset:])
+		if err != nil {
+			return err
+		}
+		h.Results = append(h.Results, result)
+		offset += result.Len()
+	}
+
+	return nil
+}
+
+// Serialize serializes HistoryReadResultArray into bytes.
+func (h *HistoryReadResultArray) Serialize() ([]byte, error) {
+	b := make([]byte, h.Len())
+	if err := h.SerializeTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// SerializeTo serializes HistoryReadResultArray into bytes.
+func (h *HistoryReadResultArray) SerializeTo(b []byte) error {
+	offset := 4
+	binary.LittleEndian.PutUint32(b[:4], uint32(h.ArraySize))
+
+	for _, result := range h.Results {
+		if err := result.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += result.Len()
+	}
+
+	return nil
+}
+
+// Len returns the actual length of HistoryReadResultArray in int.
+func (h *HistoryReadResultArray) Len() int {
+	l := 4
+	for _, result := range h.Results {
+		l += result.Len()
+	}
+
+	return l
+}
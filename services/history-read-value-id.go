@@ -0,0 +1,221 @@
+// Copyright 2018 gopcua authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package services
+
+import (
+	"encoding/binary"
+
+	"github.com/wmnsk/gopcua/datatypes"
+	"github.com/wmnsk/gopcua/errors"
+)
+
+// HistoryReadValueID is an identifier for an item to read the history of.
+//
+// Specification: Part 4, 5.10.3.2
+type HistoryReadValueID struct {
+	NodeID            datatypes.NodeID
+	IndexRange        *datatypes.String
+	DataEncoding      *datatypes.QualifiedName
+	ContinuationPoint *datatypes.ByteString
+}
+
+// NewHistoryReadValueID creates a new HistoryReadValueID.
+func NewHistoryReadValueID(nodeID datatypes.NodeID, idxRange string, qIdx uint16, qName string, cp []byte) *HistoryReadValueID {
+	return &HistoryReadValueID{
+		NodeID:            nodeID,
+		IndexRange:        datatypes.NewString(idxRange),
+		DataEncoding:      datatypes.NewQualifiedName(qIdx, qName),
+		ContinuationPoint: datatypes.NewByteString(cp),
+	}
+}
+
+// DecodeHistoryReadValueID decodes given bytes into HistoryReadValueID.
+func DecodeHistoryReadValueID(b []byte) (*HistoryReadValueID, error) {
+	h := &HistoryReadValueID{}
+	if err := h.DecodeFromBytes(b); err != nil {
+		return nil, err
+	}
+
+	return h, nil
+}
+
+// DecodeFromBytes decodes given bytes into HistoryReadValueID.
+func (h *HistoryReadValueID) DecodeFromBytes(b []byte) error {
+	nodeID, err := datatypes.DecodeNodeID(b)
+	if err != nil {
+		return err
+	}
+	h.NodeID = nodeID
+	offset := h.NodeID.Len()
+
+	h.IndexRange = &datatypes.String{}
+	if err := h.IndexRange.DecodeFromBytes(b[offset:]); err != nil {
+		return err
+	}
+	offset += h.IndexRange.Len()
+
+	h.DataEncoding = &datatypes.QualifiedName{}
+	if err := h.DataEncoding.DecodeFromBytes(b[offset:]); err != nil {
+		return err
+	}
+	offset += h.DataEncoding.Len()
+
+	h.ContinuationPoint = &datatypes.ByteString{}
+	return h.ContinuationPoint.DecodeFromBytes(b[offset:])
+}
+
+// Serialize serializes HistoryReadValueID into bytes.
+func (h *HistoryReadValueID) Serialize() ([]byte, error) {
+	b := make([]byte, h.Len())
+	if err := h.SerializeTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// SerializeTo serializes HistoryReadValueID into bytes.
+func (h *HistoryReadValueID) SerializeTo(b []byte) error {
+	offset := 0
+	if h.NodeID != nil {
+		if err := h.NodeID.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += h.NodeID.Len()
+	}
+
+	if h.IndexRange != nil {
+		if err := datatypes.ValidateIndexRange(h.IndexRange.Get()); err != nil {
+			return err
+		}
+		if err := h.IndexRange.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += h.IndexRange.Len()
+	}
+
+	if h.DataEncoding != nil {
+		if err := h.DataEncoding.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += h.DataEncoding.Len()
+	}
+
+	if h.ContinuationPoint != nil {
+		return h.ContinuationPoint.SerializeTo(b[offset:])
+	}
+
+	return nil
+}
+
+// Len returns the actual length of HistoryReadValueID in int.
+func (h *HistoryReadValueID) Len() int {
+	l := 0
+	if h.NodeID != nil {
+		l += h.NodeID.Len()
+	}
+	if h.IndexRange != nil {
+		l += h.IndexRange.Len()
+	}
+	if h.DataEncoding != nil {
+		l += h.DataEncoding.Len()
+	}
+	if h.ContinuationPoint != nil {
+		l += h.ContinuationPoint.Len()
+	}
+
+	return l
+}
+
+// HistoryReadValueIDArray represents an array of HistoryReadValueIDs.
+// It does not correspond to a certain type from the specification
+// but makes encoding and decoding easier.
+type HistoryReadValueIDArray struct {
+	ArraySize           int32
+	HistoryReadValueIDs []*HistoryReadValueID
+}
+
+// NewHistoryReadValueIDArray creates a new HistoryReadValueIDArray from multiple HistoryReadValueIDs.
+func NewHistoryReadValueIDArray(ids []*HistoryReadValueID) *HistoryReadValueIDArray {
+	if ids == nil {
+		return &HistoryReadValueIDArray{
+			ArraySize: 0,
+		}
+	}
+
+	return &HistoryReadValueIDArray{
+		ArraySize:           int32(len(ids)),
+		HistoryReadValueIDs: ids,
+	}
+}
+
+// DecodeHistoryReadValueIDArray decodes given bytes into HistoryReadValueIDArray.
+func DecodeHistoryReadValueIDArray(b []byte) (*HistoryReadValueIDArray, error) {
+	h := &HistoryReadValueIDArray{}
+	if err := h.DecodeFromBytes(b); err != nil {
+		return nil, err
+	}
+
+	return h, nil
+}
+
+// DecodeFromBytes decodes given bytes into HistoryReadValueIDArray.
+func (h *HistoryReadValueIDArray) DecodeFromBytes(b []byte) error {
+	if len(b) < 4 {
+		return errors.NewErrTooShortToDecode(h, "should be longer than 4 bytes")
+	}
+
+	h.ArraySize = int32(binary.LittleEndian.Uint32(b[:4]))
+	if h.ArraySize <= 0 {
+		return nil
+	}
+
+	offset := 4
+	for i := 1; i <= int(h.ArraySize); i++ {
+		id, err := DecodeHistoryReadValueID(b[offset:])
+		if err != nil {
+			return err
+		}
+		h.HistoryReadValueIDs = append(h.HistoryReadValueIDs, id)
+		offset += id.Len()
+	}
+
+	return nil
+}
+
+// Serialize serializes HistoryReadValueIDArray into bytes.
+func (h *HistoryReadValueIDArray) Serialize() ([]byte, error) {
+	b := make([]byte, h.Len())
+	if err := h.SerializeTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// SerializeTo serializes HistoryReadValueIDArray into bytes.
+func (h *HistoryReadValueIDArray) SerializeTo(b []byte) error {
+	offset := 4
+	binary.LittleEndian.PutUint32(b[:4], uint32(h.ArraySize))
+
+	for _, id := range h.HistoryReadValueIDs {
+		if err := id.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += id.Len()
+	}
+
+	return nil
+}
+
+// Len returns the actual length of HistoryReadValueIDArray in int.
+func (h *HistoryReadValueIDArray) Len() int {
+	l := 4
+	for _, id := range h.HistoryReadValueIDs {
+		l += id.Len()
+	}
+
+	return l
+}
@@ -0,0 +1,153 @@
+// Copyright 2018 gopcua authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package services
+
+import (
+	"encoding/binary"
+	"time"
+
+	"github.com/wmnsk/gopcua/datatypes"
+)
+
+// ModifyMonitoredItemsRequest is used to modify MonitoredItems of a Subscription.
+//
+// Specification: Part 4, 5.12.3.2
+type ModifyMonitoredItemsRequest struct {
+	TypeID *datatypes.ExpandedNodeID
+	*RequestHeader
+	SubscriptionID     uint32
+	TimestampsToReturn TimestampsToReturn
+	ItemsToModify      *MonitoredItemModifyRequestArray
+}
+
+// NewModifyMonitoredItemsRequest creates a new ModifyMonitoredItemsRequest.
+func NewModifyMonitoredItemsRequest(ts time.Time, authToken datatypes.NodeID, handle, diag, timeout uint32, auditID string, subID uint32, tsRet TimestampsToReturn, items ...*MonitoredItemModifyRequest) *ModifyMonitoredItemsRequest {
+	return &ModifyMonitoredItemsRequest{
+		TypeID: datatypes.NewExpandedNodeID(
+			false, false,
+			datatypes.NewFourByteNodeID(
+				0, ServiceTypeModifyMonitoredItemsRequest,
+			),
+			"", 0,
+		),
+		RequestHeader: NewRequestHeader(
+			authToken,
+			ts,
+			handle,
+			diag,
+			timeout,
+			auditID,
+			NewAdditionalHeader(
+				datatypes.NewExpandedNodeID(
+					false, false,
+					datatypes.NewTwoByteNodeID(0),
+					"", 0,
+				),
+				0x00,
+			),
+			nil,
+		),
+		SubscriptionID:     subID,
+		TimestampsToReturn: tsRet,
+		ItemsToModify:      NewMonitoredItemModifyRequestArray(items),
+	}
+}
+
+// DecodeModifyMonitoredItemsRequest decodes given bytes into ModifyMonitoredItemsRequest.
+func DecodeModifyMonitoredItemsRequest(b []byte) (*ModifyMonitoredItemsRequest, error) {
+	m := &ModifyMonitoredItemsRequest{}
+	if err := m.DecodeFromBytes(b); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// DecodeFromBytes decodes given bytes into ModifyMonitoredItemsRequest.
+func (m *ModifyMonitoredItemsRequest) DecodeFromBytes(b []byte) error {
+	offset := 0
+	m.TypeID = &datatypes.ExpandedNodeID{}
+	if err := m.TypeID.DecodeFromBytes(b[offset:]); err != nil {
+		return err
+	}
+	offset += m.TypeID.Len()
+
+	m.RequestHeader = &RequestHeader{}
+	if err := m.RequestHeader.DecodeFromBytes(b[offset:]); err != nil {
+		return err
+	}
+	offset += m.RequestHeader.Len() - len(m.RequestHeader.Payload)
+
+	m.SubscriptionID = binary.LittleEndian.Uint32(b[offset : offset+4])
+	offset += 4
+
+	m.TimestampsToReturn = TimestampsToReturn(binary.LittleEndian.Uint32(b[offset : offset+4]))
+	offset += 4
+
+	m.ItemsToModify = &MonitoredItemModifyRequestArray{}
+	return m.ItemsToModify.DecodeFromBytes(b[offset:])
+}
+
+// Serialize serializes ModifyMonitoredItemsRequest into bytes.
+func (m *ModifyMonitoredItemsRequest) Serialize() ([]byte, error) {
+	b := make([]byte, m.Len())
+	if err := m.SerializeTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// SerializeTo serializes ModifyMonitoredItemsRequest into bytes.
+func (m *ModifyMonitoredItemsRequest) SerializeTo(b []byte) error {
+	offset := 0
+	if m.TypeID != nil {
+		if err := m.TypeID.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += m.TypeID.Len()
+	}
+
+	if m.RequestHeader != nil {
+		if err := m.RequestHeader.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += m.RequestHeader.Len() - len(m.Payload)
+	}
+
+	binary.LittleEndian.PutUint32(b[offset:offset+4], m.SubscriptionID)
+	offset += 4
+
+	binary.LittleEndian.PutUint32(b[offset:offset+4], uint32(m.TimestampsToReturn))
+	offset += 4
+
+	if m.ItemsToModify != nil {
+		return m.ItemsToModify.SerializeTo(b[offset:])
+	}
+
+	return nil
+}
+
+// Len returns the actual length of ModifyMonitoredItemsRequest in int.
+func (m *ModifyMonitoredItemsRequest) Len() int {
+	// SubscriptionID + TimestampsToReturn
+	l := 8
+	if m.TypeID != nil {
+		l += m.TypeID.Len()
+	}
+	if m.RequestHeader != nil {
+		l += (m.RequestHeader.Len() - len(m.Payload))
+	}
+	if m.ItemsToModify != nil {
+		l += m.ItemsToModify.Len()
+	}
+
+	return l
+}
+
+// ServiceType returns type of Service in uint16.
+func (m *ModifyMonitoredItemsRequest) ServiceType() uint16 {
+	return ServiceTypeModifyMonitoredItemsRequest
+}
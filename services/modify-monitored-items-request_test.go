@@ -0,0 +1,123 @@
+// Copyright 2018 gopcua authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/wmnsk/gopcua/datatypes"
+)
+
+var modifyMonitoredItemsRequestCases = []struct {
+	description string
+	structured  *ModifyMonitoredItemsRequest
+	serialized  []byte
+}{
+	{
+		"normal",
+		NewModifyMonitoredItemsRequest(
+			time.Date(2018, time.August, 10, 23, 0, 0, 0, time.UTC),
+			datatypes.NewTwoByteNodeID(0), 1, 0, 0, "",
+			1, TimestampsToReturnBoth,
+			NewMonitoredItemModifyRequest(
+				1,
+				NewMonitoringParameters(1, 500, datatypes.NewNullExtensionObject(), 10, false),
+			),
+		),
+		[]byte{ // ModifyMonitoredItemsRequest
+			// TypeID
+			0x01, 0x00, 0xfb, 0x02,
+			// RequestHeader
+			// AuthenticationToken
+			0x00, 0x00,
+			// Timestamp
+			0x00, 0x98, 0x67, 0xdd, 0xfd, 0x30, 0xd4, 0x01,
+			// RequestHandle
+			0x01, 0x00, 0x00, 0x00,
+			// ReturnDiagnostics
+			0x00, 0x00, 0x00, 0x00,
+			// AuditEntryID
+			0xff, 0xff, 0xff, 0xff,
+			// TimeoutHint
+			0x00, 0x00, 0x00, 0x00,
+			// AdditionalHeader
+			0x00, 0x00, 0x00,
+			// SubscriptionID
+			0x01, 0x00, 0x00, 0x00,
+			// TimestampsToReturn
+			0x02, 0x00, 0x00, 0x00,
+			// ItemsToModify
+			// ArraySize
+			0x01, 0x00, 0x00, 0x00,
+			// MonitoredItemID
+			0x01, 0x00, 0x00, 0x00,
+			// RequestedParameters
+			// ClientHandle
+			0x01, 0x00, 0x00, 0x00,
+			// SamplingInterval
+			0x00, 0x00, 0x00, 0x00, 0x00, 0x40, 0x7f, 0x40,
+			// Filter
+			0x00, 0x00, 0x00,
+			// QueueSize
+			0x0a, 0x00, 0x00, 0x00,
+			// DiscardOldest
+			0x00,
+		},
+	},
+}
+
+func TestDecodeModifyMonitoredItemsRequest(t *testing.T) {
+	for _, c := range modifyMonitoredItemsRequestCases {
+		got, err := DecodeModifyMonitoredItemsRequest(c.serialized)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// need to clear Payload here.
+		got.Payload = nil
+
+		if diff := cmp.Diff(got, c.structured, decodeCmpOpt); diff != "" {
+			t.Errorf("%s failed\n%s", c.description, diff)
+		}
+	}
+}
+
+func TestSerializeModifyMonitoredItemsRequest(t *testing.T) {
+	for _, c := range modifyMonitoredItemsRequestCases {
+		got, err := c.structured.Serialize()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if diff := cmp.Diff(got, c.serialized); diff != "" {
+			t.Errorf("%s failed\n%s", c.description, diff)
+		}
+	}
+}
+
+func TestModifyMonitoredItemsRequestLen(t *testing.T) {
+	for _, c := range modifyMonitoredItemsRequestCases {
+		got := c.structured.Len()
+
+		if diff := cmp.Diff(got, len(c.serialized)); diff != "" {
+			t.Errorf("%s failed\n%s", c.description, diff)
+		}
+	}
+}
+
+func TestModifyMonitoredItemsRequestServiceType(t *testing.T) {
+	for _, c := range modifyMonitoredItemsRequestCases {
+		if c.structured.ServiceType() != ServiceTypeModifyMonitoredItemsRequest {
+			t.Errorf(
+				"ServiceType doesn't match. Want: %d, Got: %d",
+				ServiceTypeModifyMonitoredItemsRequest,
+				c.structured.ServiceType(),
+			)
+		}
+	}
+}
@@ -0,0 +1,153 @@
+// Copyright 2018 gopcua authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package services
+
+import (
+	"time"
+
+	"github.com/wmnsk/gopcua/datatypes"
+)
+
+// ModifyMonitoredItemsResponse represents the response to a ModifyMonitoredItemsRequest.
+// Results are in the same order as the items in the request.
+//
+// Specification: Part 4, 5.12.3.2
+type ModifyMonitoredItemsResponse struct {
+	TypeID *datatypes.ExpandedNodeID
+	*ResponseHeader
+	Results         *MonitoredItemModifyResultArray
+	DiagnosticInfos *DiagnosticInfoArray
+}
+
+// NewModifyMonitoredItemsResponse creates a new ModifyMonitoredItemsResponse.
+func NewModifyMonitoredItemsResponse(ts time.Time, handle, code uint32, diag *DiagnosticInfo, strs []string, results []*MonitoredItemModifyResult, diags []*DiagnosticInfo) *ModifyMonitoredItemsResponse {
+	return &ModifyMonitoredItemsResponse{
+		TypeID: datatypes.NewExpandedNodeID(
+			false, false,
+			datatypes.NewFourByteNodeID(
+				0, ServiceTypeModifyMonitoredItemsResponse,
+			),
+			"", 0,
+		),
+		ResponseHeader: NewResponseHeader(
+			ts,
+			handle,
+			code,
+			diag,
+			strs,
+			NewAdditionalHeader(
+				datatypes.NewExpandedNodeID(
+					false, false,
+					datatypes.NewTwoByteNodeID(0),
+					"", 0,
+				),
+				0x00,
+			),
+			nil,
+		),
+		Results:         NewMonitoredItemModifyResultArray(results),
+		DiagnosticInfos: NewDiagnosticInfoArray(diags),
+	}
+}
+
+// DecodeModifyMonitoredItemsResponse decodes given bytes into ModifyMonitoredItemsResponse.
+func DecodeModifyMonitoredItemsResponse(b []byte) (*ModifyMonitoredItemsResponse, error) {
+	m := &ModifyMonitoredItemsResponse{}
+	if err := m.DecodeFromBytes(b); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// DecodeFromBytes decodes given bytes into ModifyMonitoredItemsResponse.
+func (m *ModifyMonitoredItemsResponse) DecodeFromBytes(b []byte) error {
+	offset := 0
+	m.TypeID = &datatypes.ExpandedNodeID{}
+	if err := m.TypeID.DecodeFromBytes(b[offset:]); err != nil {
+		return err
+	}
+	offset += m.TypeID.Len()
+
+	m.ResponseHeader = &ResponseHeader{}
+	if err := m.ResponseHeader.DecodeFromBytes(b[offset:]); err != nil {
+		return err
+	}
+	offset += m.ResponseHeader.Len() - len(m.ResponseHeader.Payload)
+
+	m.Results = &MonitoredItemModifyResultArray{}
+	if err := m.Results.DecodeFromBytes(b[offset:]); err != nil {
+		return err
+	}
+	offset += m.Results.Len()
+
+	m.DiagnosticInfos = &DiagnosticInfoArray{}
+	return m.DiagnosticInfos.DecodeFromBytes(b[offset:])
+}
+
+// Serialize serializes ModifyMonitoredItemsResponse into bytes.
+func (m *ModifyMonitoredItemsResponse) Serialize() ([]byte, error) {
+	b := make([]byte, m.Len())
+	if err := m.SerializeTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// SerializeTo serializes ModifyMonitoredItemsResponse into bytes.
+func (m *ModifyMonitoredItemsResponse) SerializeTo(b []byte) error {
+	offset := 0
+	if m.TypeID != nil {
+		if err := m.TypeID.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += m.TypeID.Len()
+	}
+
+	if m.ResponseHeader != nil {
+		if err := m.ResponseHeader.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += m.ResponseHeader.Len() - len(m.Payload)
+	}
+
+	if m.Results != nil {
+		if err := m.Results.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += m.Results.Len()
+	}
+
+	if m.DiagnosticInfos != nil {
+		return m.DiagnosticInfos.SerializeTo(b[offset:])
+	}
+
+	return nil
+}
+
+// Len returns the actual length of ModifyMonitoredItemsResponse in int.
+func (m *ModifyMonitoredItemsResponse) Len() int {
+	l := 0
+	if m.TypeID != nil {
+		l += m.TypeID.Len()
+	}
+	if m.ResponseHeader != nil {
+		l += (m.ResponseHeader.Len() - len(m.Payload))
+	}
+	if m.Results != nil {
+		l += m.Results.Len()
+	}
+	if m.DiagnosticInfos != nil {
+		l += m.DiagnosticInfos.Len()
+	}
+
+	return l
+}
+
+// ServiceType returns type of Service in uint16.
+func (m *ModifyMonitoredItemsResponse) ServiceType() uint16 {
+	return ServiceTypeModifyMonitoredItemsResponse
+}
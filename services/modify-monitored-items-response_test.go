@@ -0,0 +1,122 @@
+// Copyright 2018 gopcua authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/wmnsk/gopcua/datatypes"
+)
+
+var modifyMonitoredItemsResponseCases = []struct {
+	description string
+	structured  *ModifyMonitoredItemsResponse
+	serialized  []byte
+}{
+	{
+		"normal",
+		NewModifyMonitoredItemsResponse(
+			time.Date(2018, time.August, 10, 23, 0, 0, 0, time.UTC),
+			1, 0, nil, nil,
+			[]*MonitoredItemModifyResult{
+				NewMonitoredItemModifyResult(0, 500, 10, datatypes.NewNullExtensionObject()),
+				NewMonitoredItemModifyResult(0x80420000, 0, 0, datatypes.NewNullExtensionObject()),
+			},
+			nil,
+		),
+		[]byte{ // ModifyMonitoredItemsResponse
+			// TypeID
+			0x01, 0x00, 0xfe, 0x02,
+			// ResponseHeader
+			// Timestamp
+			0x00, 0x98, 0x67, 0xdd, 0xfd, 0x30, 0xd4, 0x01,
+			// RequestHandle
+			0x01, 0x00, 0x00, 0x00,
+			// ServiceResult
+			0x00, 0x00, 0x00, 0x00,
+			// ServiceDiagnostics
+			0x00,
+			// StringTable
+			0x00, 0x00, 0x00, 0x00,
+			// AdditionalHeader
+			0x00, 0x00, 0x00,
+			// Results
+			// ArraySize
+			0x02, 0x00, 0x00, 0x00,
+			// StatusCode
+			0x00, 0x00, 0x00, 0x00,
+			// RevisedSamplingInterval
+			0x00, 0x00, 0x00, 0x00, 0x00, 0x40, 0x7f, 0x40,
+			// RevisedQueueSize
+			0x0a, 0x00, 0x00, 0x00,
+			// FilterResult
+			0x00, 0x00, 0x00,
+			// StatusCode: BadMonitoredItemIdInvalid
+			0x00, 0x00, 0x42, 0x80,
+			// RevisedSamplingInterval
+			0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			// RevisedQueueSize
+			0x00, 0x00, 0x00, 0x00,
+			// FilterResult
+			0x00, 0x00, 0x00,
+			// DiagnosticInfos
+			0x00, 0x00, 0x00, 0x00,
+		},
+	},
+}
+
+func TestDecodeModifyMonitoredItemsResponse(t *testing.T) {
+	for _, c := range modifyMonitoredItemsResponseCases {
+		got, err := DecodeModifyMonitoredItemsResponse(c.serialized)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// need to clear Payload here.
+		got.Payload = nil
+
+		if diff := cmp.Diff(got, c.structured, decodeCmpOpt); diff != "" {
+			t.Errorf("%s failed\n%s", c.description, diff)
+		}
+	}
+}
+
+func TestSerializeModifyMonitoredItemsResponse(t *testing.T) {
+	for _, c := range modifyMonitoredItemsResponseCases {
+		got, err := c.structured.Serialize()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if diff := cmp.Diff(got, c.serialized); diff != "" {
+			t.Errorf("%s failed\n%s", c.description, diff)
+		}
+	}
+}
+
+func TestModifyMonitoredItemsResponseLen(t *testing.T) {
+	for _, c := range modifyMonitoredItemsResponseCases {
+		got := c.structured.Len()
+
+		if diff := cmp.Diff(got, len(c.serialized)); diff != "" {
+			t.Errorf("%s failed\n%s", c.description, diff)
+		}
+	}
+}
+
+func TestModifyMonitoredItemsResponseServiceType(t *testing.T) {
+	for _, c := range modifyMonitoredItemsResponseCases {
+		if c.structured.ServiceType() != ServiceTypeModifyMonitoredItemsResponse {
+			t.Errorf(
+				"ServiceType doesn't match. Want: %d, Got: %d",
+				ServiceTypeModifyMonitoredItemsResponse,
+				c.structured.ServiceType(),
+			)
+		}
+	}
+}
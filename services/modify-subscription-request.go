@@ -0,0 +1,174 @@
+// Copyright 2018 gopcua authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package services
+
+import (
+	"encoding/binary"
+	"math"
+	"time"
+
+	"github.com/wmnsk/gopcua/datatypes"
+)
+
+// ModifySubscriptionRequest is used to modify a Subscription.
+//
+// Specification: Part 4, 5.13.3.2
+type ModifySubscriptionRequest struct {
+	TypeID *datatypes.ExpandedNodeID
+	*RequestHeader
+	SubscriptionID              uint32
+	RequestedPublishingInterval float64
+	RequestedLifetimeCount      uint32
+	RequestedMaxKeepAliveCount  uint32
+	MaxNotificationsPerPublish  uint32
+	Priority                    uint8
+}
+
+// NewModifySubscriptionRequest creates a new ModifySubscriptionRequest.
+func NewModifySubscriptionRequest(ts time.Time, authToken datatypes.NodeID, handle, diag, timeout uint32, auditID string, subID uint32, interval float64, lifetime, keepAlive, maxNotifications uint32, priority uint8) *ModifySubscriptionRequest {
+	return &ModifySubscriptionRequest{
+		TypeID: datatypes.NewExpandedNodeID(
+			false, false,
+			datatypes.NewFourByteNodeID(
+				0, ServiceTypeModifySubscriptionRequest,
+			),
+			"", 0,
+		),
+		RequestHeader: NewRequestHeader(
+			authToken,
+			ts,
+			handle,
+			diag,
+			timeout,
+			auditID,
+			NewAdditionalHeader(
+				datatypes.NewExpandedNodeID(
+					false, false,
+					datatypes.NewTwoByteNodeID(0),
+					"", 0,
+				),
+				0x00,
+			),
+			nil,
+		),
+		SubscriptionID:              subID,
+		RequestedPublishingInterval: interval,
+		RequestedLifetimeCount:      lifetime,
+		RequestedMaxKeepAliveCount:  keepAlive,
+		MaxNotificationsPerPublish:  maxNotifications,
+		Priority:                    priority,
+	}
+}
+
+// DecodeModifySubscriptionRequest decodes given bytes into ModifySubscriptionRequest.
+func DecodeModifySubscriptionRequest(b []byte) (*ModifySubscriptionRequest, error) {
+	m := &ModifySubscriptionRequest{}
+	if err := m.DecodeFromBytes(b); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// DecodeFromBytes decodes given bytes into ModifySubscriptionRequest.
+func (m *ModifySubscriptionRequest) DecodeFromBytes(b []byte) error {
+	offset := 0
+	m.TypeID = &datatypes.ExpandedNodeID{}
+	if err := m.TypeID.DecodeFromBytes(b[offset:]); err != nil {
+		return err
+	}
+	offset += m.TypeID.Len()
+
+	m.RequestHeader = &RequestHeader{}
+	if err := m.RequestHeader.DecodeFromBytes(b[offset:]); err != nil {
+		return err
+	}
+	offset += m.RequestHeader.Len() - len(m.RequestHeader.Payload)
+
+	m.SubscriptionID = binary.LittleEndian.Uint32(b[offset : offset+4])
+	offset += 4
+
+	m.RequestedPublishingInterval = math.Float64frombits(binary.LittleEndian.Uint64(b[offset : offset+8]))
+	offset += 8
+
+	m.RequestedLifetimeCount = binary.LittleEndian.Uint32(b[offset : offset+4])
+	offset += 4
+
+	m.RequestedMaxKeepAliveCount = binary.LittleEndian.Uint32(b[offset : offset+4])
+	offset += 4
+
+	m.MaxNotificationsPerPublish = binary.LittleEndian.Uint32(b[offset : offset+4])
+	offset += 4
+
+	m.Priority = b[offset]
+
+	return nil
+}
+
+// Serialize serializes ModifySubscriptionRequest into bytes.
+func (m *ModifySubscriptionRequest) Serialize() ([]byte, error) {
+	b := make([]byte, m.Len())
+	if err := m.SerializeTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// SerializeTo serializes ModifySubscriptionRequest into bytes.
+func (m *ModifySubscriptionRequest) SerializeTo(b []byte) error {
+	offset := 0
+	if m.TypeID != nil {
+		if err := m.TypeID.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += m.TypeID.Len()
+	}
+
+	if m.RequestHeader != nil {
+		if err := m.RequestHeader.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += m.RequestHeader.Len() - len(m.Payload)
+	}
+
+	binary.LittleEndian.PutUint32(b[offset:offset+4], m.SubscriptionID)
+	offset += 4
+
+	binary.LittleEndian.PutUint64(b[offset:offset+8], math.Float64bits(m.RequestedPublishingInterval))
+	offset += 8
+
+	binary.LittleEndian.PutUint32(b[offset:offset+4], m.RequestedLifetimeCount)
+	offset += 4
+
+	binary.LittleEndian.PutUint32(b[offset:offset+4], m.RequestedMaxKeepAliveCount)
+	offset += 4
+
+	binary.LittleEndian.PutUint32(b[offset:offset+4], m.MaxNotificationsPerPublish)
+	offset += 4
+
+	b[offset] = m.Priority
+
+	return nil
+}
+
+// Len returns the actual length of ModifySubscriptionRequest in int.
+func (m *ModifySubscriptionRequest) Len() int {
+	// SubscriptionID + RequestedPublishingInterval + RequestedLifetimeCount + RequestedMaxKeepAliveCount + MaxNotificationsPerPublish + Priority
+	l := 25
+	if m.TypeID != nil {
+		l += m.TypeID.Len()
+	}
+	if m.RequestHeader != nil {
+		l += (m.RequestHeader.Len() - len(m.Payload))
+	}
+
+	return l
+}
+
+// ServiceType returns type of Service in uint16.
+func (m *ModifySubscriptionRequest) ServiceType() uint16 {
+	return ServiceTypeModifySubscriptionRequest
+}
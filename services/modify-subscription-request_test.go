@@ -0,0 +1,111 @@
+// Copyright 2018 gopcua authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/wmnsk/gopcua/datatypes"
+)
+
+var modifySubscriptionRequestCases = []struct {
+	description string
+	structured  *ModifySubscriptionRequest
+	serialized  []byte
+}{
+	{
+		"normal",
+		NewModifySubscriptionRequest(
+			time.Date(2018, time.August, 10, 23, 0, 0, 0, time.UTC),
+			datatypes.NewTwoByteNodeID(0), 1, 0, 0, "",
+			1, 1000, 60, 20, 0, 0,
+		),
+		[]byte{ // ModifySubscriptionRequest
+			// TypeID
+			0x01, 0x00, 0x19, 0x03,
+			// RequestHeader
+			// AuthenticationToken
+			0x00, 0x00,
+			// Timestamp
+			0x00, 0x98, 0x67, 0xdd, 0xfd, 0x30, 0xd4, 0x01,
+			// RequestHandle
+			0x01, 0x00, 0x00, 0x00,
+			// ReturnDiagnostics
+			0x00, 0x00, 0x00, 0x00,
+			// AuditEntryID
+			0xff, 0xff, 0xff, 0xff,
+			// TimeoutHint
+			0x00, 0x00, 0x00, 0x00,
+			// AdditionalHeader
+			0x00, 0x00, 0x00,
+			// SubscriptionID
+			0x01, 0x00, 0x00, 0x00,
+			// RequestedPublishingInterval
+			0x00, 0x00, 0x00, 0x00, 0x00, 0x40, 0x8f, 0x40,
+			// RequestedLifetimeCount
+			0x3c, 0x00, 0x00, 0x00,
+			// RequestedMaxKeepAliveCount
+			0x14, 0x00, 0x00, 0x00,
+			// MaxNotificationsPerPublish
+			0x00, 0x00, 0x00, 0x00,
+			// Priority
+			0x00,
+		},
+	},
+}
+
+func TestDecodeModifySubscriptionRequest(t *testing.T) {
+	for _, c := range modifySubscriptionRequestCases {
+		got, err := DecodeModifySubscriptionRequest(c.serialized)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// need to clear Payload here.
+		got.Payload = nil
+
+		if diff := cmp.Diff(got, c.structured, decodeCmpOpt); diff != "" {
+			t.Errorf("%s failed\n%s", c.description, diff)
+		}
+	}
+}
+
+func TestSerializeModifySubscriptionRequest(t *testing.T) {
+	for _, c := range modifySubscriptionRequestCases {
+		got, err := c.structured.Serialize()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if diff := cmp.Diff(got, c.serialized); diff != "" {
+			t.Errorf("%s failed\n%s", c.description, diff)
+		}
+	}
+}
+
+func TestModifySubscriptionRequestLen(t *testing.T) {
+	for _, c := range modifySubscriptionRequestCases {
+		got := c.structured.Len()
+
+		if diff := cmp.Diff(got, len(c.serialized)); diff != "" {
+			t.Errorf("%s failed\n%s", c.description, diff)
+		}
+	}
+}
+
+func TestModifySubscriptionRequestServiceType(t *testing.T) {
+	for _, c := range modifySubscriptionRequestCases {
+		if c.structured.ServiceType() != ServiceTypeModifySubscriptionRequest {
+			t.Errorf(
+				"ServiceType doesn't match. Want: %d, Got: %d",
+				ServiceTypeModifySubscriptionRequest,
+				c.structured.ServiceType(),
+			)
+		}
+	}
+}
@@ -0,0 +1,150 @@
+// Copyright 2018 gopcua authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package services
+
+import (
+	"encoding/binary"
+	"math"
+	"time"
+
+	"github.com/wmnsk/gopcua/datatypes"
+)
+
+// ModifySubscriptionResponse represents the response to a ModifySubscriptionRequest.
+// The revised values are the ones actually used by the Server.
+//
+// Specification: Part 4, 5.13.3.2
+type ModifySubscriptionResponse struct {
+	TypeID *datatypes.ExpandedNodeID
+	*ResponseHeader
+	RevisedPublishingInterval float64
+	RevisedLifetimeCount      uint32
+	RevisedMaxKeepAliveCount  uint32
+}
+
+// NewModifySubscriptionResponse creates a new ModifySubscriptionResponse.
+func NewModifySubscriptionResponse(ts time.Time, handle, code uint32, diag *DiagnosticInfo, strs []string, interval float64, lifetime, keepAlive uint32) *ModifySubscriptionResponse {
+	return &ModifySubscriptionResponse{
+		TypeID: datatypes.NewExpandedNodeID(
+			false, false,
+			datatypes.NewFourByteNodeID(
+				0, ServiceTypeModifySubscriptionResponse,
+			),
+			"", 0,
+		),
+		ResponseHeader: NewResponseHeader(
+			ts,
+			handle,
+			code,
+			diag,
+			strs,
+			NewAdditionalHeader(
+				datatypes.NewExpandedNodeID(
+					false, false,
+					datatypes.NewTwoByteNodeID(0),
+					"", 0,
+				),
+				0x00,
+			),
+			nil,
+		),
+		RevisedPublishingInterval: interval,
+		RevisedLifetimeCount:      lifetime,
+		RevisedMaxKeepAliveCount:  keepAlive,
+	}
+}
+
+// DecodeModifySubscriptionResponse decodes given bytes into ModifySubscriptionResponse.
+func DecodeModifySubscriptionResponse(b []byte) (*ModifySubscriptionResponse, error) {
+	m := &ModifySubscriptionResponse{}
+	if err := m.DecodeFromBytes(b); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// DecodeFromBytes decodes given bytes into ModifySubscriptionResponse.
+func (m *ModifySubscriptionResponse) DecodeFromBytes(b []byte) error {
+	offset := 0
+	m.TypeID = &datatypes.ExpandedNodeID{}
+	if err := m.TypeID.DecodeFromBytes(b[offset:]); err != nil {
+		return err
+	}
+	offset += m.TypeID.Len()
+
+	m.ResponseHeader = &ResponseHeader{}
+	if err := m.ResponseHeader.DecodeFromBytes(b[offset:]); err != nil {
+		return err
+	}
+	offset += m.ResponseHeader.Len() - len(m.ResponseHeader.Payload)
+
+	m.RevisedPublishingInterval = math.Float64frombits(binary.LittleEndian.Uint64(b[offset : offset+8]))
+	offset += 8
+
+	m.RevisedLifetimeCount = binary.LittleEndian.Uint32(b[offset : offset+4])
+	offset += 4
+
+	m.RevisedMaxKeepAliveCount = binary.LittleEndian.Uint32(b[offset : offset+4])
+
+	return nil
+}
+
+// Serialize serializes ModifySubscriptionResponse into bytes.
+func (m *ModifySubscriptionResponse) Serialize() ([]byte, error) {
+	b := make([]byte, m.Len())
+	if err := m.SerializeTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// SerializeTo serializes ModifySubscriptionResponse into bytes.
+func (m *ModifySubscriptionResponse) SerializeTo(b []byte) error {
+	offset := 0
+	if m.TypeID != nil {
+		if err := m.TypeID.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += m.TypeID.Len()
+	}
+
+	if m.ResponseHeader != nil {
+		if err := m.ResponseHeader.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += m.ResponseHeader.Len() - len(m.Payload)
+	}
+
+	binary.LittleEndian.PutUint64(b[offset:offset+8], math.Float64bits(m.RevisedPublishingInterval))
+	offset += 8
+
+	binary.LittleEndian.PutUint32(b[offset:offset+4], m.RevisedLifetimeCount)
+	offset += 4
+
+	binary.LittleEndian.PutUint32(b[offset:offset+4], m.RevisedMaxKeepAliveCount)
+
+	return nil
+}
+
+// Len returns the actual length of ModifySubscriptionResponse in int.
+func (m *ModifySubscriptionResponse) Len() int {
+	// RevisedPublishingInterval + RevisedLifetimeCount + RevisedMaxKeepAliveCount
+	l := 16
+	if m.TypeID != nil {
+		l += m.TypeID.Len()
+	}
+	if m.ResponseHeader != nil {
+		l += (m.ResponseHeader.Len() - len(m.Payload))
+	}
+
+	return l
+}
+
+// ServiceType returns type of Service in uint16.
+func (m *ModifySubscriptionResponse) ServiceType() uint16 {
+	return ServiceTypeModifySubscriptionResponse
+}
@@ -0,0 +1,101 @@
+// Copyright 2018 gopcua authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+var modifySubscriptionResponseCases = []struct {
+	description string
+	structured  *ModifySubscriptionResponse
+	serialized  []byte
+}{
+	{
+		"normal",
+		NewModifySubscriptionResponse(
+			time.Date(2018, time.August, 10, 23, 0, 0, 0, time.UTC),
+			1, 0, nil, nil,
+			500, 30, 10,
+		),
+		[]byte{ // ModifySubscriptionResponse
+			// TypeID
+			0x01, 0x00, 0x1c, 0x03,
+			// ResponseHeader
+			// Timestamp
+			0x00, 0x98, 0x67, 0xdd, 0xfd, 0x30, 0xd4, 0x01,
+			// RequestHandle
+			0x01, 0x00, 0x00, 0x00,
+			// ServiceResult
+			0x00, 0x00, 0x00, 0x00,
+			// ServiceDiagnostics
+			0x00,
+			// StringTable
+			0x00, 0x00, 0x00, 0x00,
+			// AdditionalHeader
+			0x00, 0x00, 0x00,
+			// RevisedPublishingInterval
+			0x00, 0x00, 0x00, 0x00, 0x00, 0x40, 0x7f, 0x40,
+			// RevisedLifetimeCount
+			0x1e, 0x00, 0x00, 0x00,
+			// RevisedMaxKeepAliveCount
+			0x0a, 0x00, 0x00, 0x00,
+		},
+	},
+}
+
+func TestDecodeModifySubscriptionResponse(t *testing.T) {
+	for _, c := range modifySubscriptionResponseCases {
+		got, err := DecodeModifySubscriptionResponse(c.serialized)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// need to clear Payload here.
+		got.Payload = nil
+
+		if diff := cmp.Diff(got, c.structured, decodeCmpOpt); diff != "" {
+			t.Errorf("%s failed\n%s", c.description, diff)
+		}
+	}
+}
+
+func TestSerializeModifySubscriptionResponse(t *testing.T) {
+	for _, c := range modifySubscriptionResponseCases {
+		got, err := c.structured.Serialize()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if diff := cmp.Diff(got, c.serialized); diff != "" {
+			t.Errorf("%s failed\n%s", c.description, diff)
+		}
+	}
+}
+
+func TestModifySubscriptionResponseLen(t *testing.T) {
+	for _, c := range modifySubscriptionResponseCases {
+		got := c.structured.Len()
+
+		if diff := cmp.Diff(got, len(c.serialized)); diff != "" {
+			t.Errorf("%s failed\n%s", c.description, diff)
+		}
+	}
+}
+
+func TestModifySubscriptionResponseServiceType(t *testing.T) {
+	for _, c := range modifySubscriptionResponseCases {
+		if c.structured.ServiceType() != ServiceTypeModifySubscriptionResponse {
+			t.Errorf(
+				"ServiceType doesn't match. Want: %d, Got: %d",
+				ServiceTypeModifySubscriptionResponse,
+				c.structured.ServiceType(),
+			)
+		}
+	}
+}
@@ -0,0 +1,191 @@
+// Copyright 2018 gopcua authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package services
+
+import (
+	"encoding/binary"
+
+	"github.com/wmnsk/gopcua/datatypes"
+	"github.com/wmnsk/gopcua/errors"
+)
+
+// MonitoredItemCreateRequest represents a MonitoredItem to be created in CreateMonitoredItemsRequest.
+//
+// Specification: Part 4, 5.12.2.2
+type MonitoredItemCreateRequest struct {
+	ItemToMonitor       *datatypes.ReadValueID
+	MonitoringMode      MonitoringMode
+	RequestedParameters *MonitoringParameters
+}
+
+// NewMonitoredItemCreateRequest creates a new MonitoredItemCreateRequest.
+func NewMonitoredItemCreateRequest(item *datatypes.ReadValueID, mode MonitoringMode, params *MonitoringParameters) *MonitoredItemCreateRequest {
+	return &MonitoredItemCreateRequest{
+		ItemToMonitor:       item,
+		MonitoringMode:      mode,
+		RequestedParameters: params,
+	}
+}
+
+// DecodeMonitoredItemCreateRequest decodes given bytes into MonitoredItemCreateRequest.
+func DecodeMonitoredItemCreateRequest(b []byte) (*MonitoredItemCreateRequest, error) {
+	m := &MonitoredItemCreateRequest{}
+	if err := m.DecodeFromBytes(b); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// DecodeFromBytes decodes given bytes into MonitoredItemCreateRequest.
+func (m *MonitoredItemCreateRequest) DecodeFromBytes(b []byte) error {
+	offset := 0
+	m.ItemToMonitor = &datatypes.ReadValueID{}
+	if err := m.ItemToMonitor.DecodeFromBytes(b[offset:]); err != nil {
+		return err
+	}
+	offset += m.ItemToMonitor.Len()
+
+	m.MonitoringMode = MonitoringMode(binary.LittleEndian.Uint32(b[offset : offset+4]))
+	offset += 4
+
+	m.RequestedParameters = &MonitoringParameters{}
+	return m.RequestedParameters.DecodeFromBytes(b[offset:])
+}
+
+// Serialize serializes MonitoredItemCreateRequest into bytes.
+func (m *MonitoredItemCreateRequest) Serialize() ([]byte, error) {
+	b := make([]byte, m.Len())
+	if err := m.SerializeTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// SerializeTo serializes MonitoredItemCreateRequest into bytes.
+func (m *MonitoredItemCreateRequest) SerializeTo(b []byte) error {
+	offset := 0
+	if m.ItemToMonitor != nil {
+		if err := m.ItemToMonitor.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += m.ItemToMonitor.Len()
+	}
+
+	binary.LittleEndian.PutUint32(b[offset:offset+4], uint32(m.MonitoringMode))
+	offset += 4
+
+	if m.RequestedParameters != nil {
+		return m.RequestedParameters.SerializeTo(b[offset:])
+	}
+
+	return nil
+}
+
+// Len returns the actual length of MonitoredItemCreateRequest in int.
+func (m *MonitoredItemCreateRequest) Len() int {
+	// MonitoringMode
+	l := 4
+	if m.ItemToMonitor != nil {
+		l += m.ItemToMonitor.Len()
+	}
+	if m.RequestedParameters != nil {
+		l += m.RequestedParameters.Len()
+	}
+
+	return l
+}
+
+// MonitoredItemCreateRequestArray represents an array of MonitoredItemCreateRequests.
+// It does not correspond to a certain type from the specification
+// but makes encoding and decoding easier.
+type MonitoredItemCreateRequestArray struct {
+	ArraySize                   int32
+	MonitoredItemCreateRequests []*MonitoredItemCreateRequest
+}
+
+// NewMonitoredItemCreateRequestArray creates a new MonitoredItemCreateRequestArray from multiple MonitoredItemCreateRequests.
+func NewMonitoredItemCreateRequestArray(monitoredItemCreateRequests []*MonitoredItemCreateRequest) *MonitoredItemCreateRequestArray {
+	if monitoredItemCreateRequests == nil {
+		return &MonitoredItemCreateRequestArray{
+			ArraySize: 0,
+		}
+	}
+
+	return &MonitoredItemCreateRequestArray{
+		ArraySize:                   int32(len(monitoredItemCreateRequests)),
+		MonitoredItemCreateRequests: monitoredItemCreateRequests,
+	}
+}
+
+// DecodeMonitoredItemCreateRequestArray decodes given bytes into MonitoredItemCreateRequestArray.
+func DecodeMonitoredItemCreateRequestArray(b []byte) (*MonitoredItemCreateRequestArray, error) {
+	m := &MonitoredItemCreateRequestArray{}
+	if err := m.DecodeFromBytes(b); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// DecodeFromBytes decodes given bytes into MonitoredItemCreateRequestArray.
+func (m *MonitoredItemCreateRequestArray) DecodeFromBytes(b []byte) error {
+	if len(b) < 4 {
+		return errors.NewErrTooShortToDecode(m, "should be longer than 4 bytes")
+	}
+
+	m.ArraySize = int32(binary.LittleEndian.Uint32(b[:4]))
+	if m.ArraySize <= 0 {
+		return nil
+	}
+
+	offset := 4
+	for i := 1; i <= int(m.ArraySize); i++ {
+		monitoredItemCreateRequest, err := DecodeMonitoredItemCreateRequest(b[offset:])
+		if err != nil {
+			return err
+		}
+		m.MonitoredItemCreateRequests = append(m.MonitoredItemCreateRequests, monitoredItemCreateRequest)
+		offset += monitoredItemCreateRequest.Len()
+	}
+
+	return nil
+}
+
+// Serialize serializes MonitoredItemCreateRequestArray into bytes.
+func (m *MonitoredItemCreateRequestArray) Serialize() ([]byte, error) {
+	b := make([]byte, m.Len())
+	if err := m.SerializeTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// SerializeTo serializes MonitoredItemCreateRequestArray into bytes.
+func (m *MonitoredItemCreateRequestArray) SerializeTo(b []byte) error {
+	offset := 4
+	binary.LittleEndian.PutUint32(b[:4], uint32(m.ArraySize))
+
+	for _, monitoredItemCreateRequest := range m.MonitoredItemCreateRequests {
+		if err := monitoredItemCreateRequest.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += monitoredItemCreateRequest.Len()
+	}
+
+	return nil
+}
+
+// Len returns the actual length of MonitoredItemCreateRequestArray in int.
+func (m *MonitoredItemCreateRequestArray) Len() int {
+	l := 4
+	for _, monitoredItemCreateRequest := range m.MonitoredItemCreateRequests {
+		l += monitoredItemCreateRequest.Len()
+	}
+
+	return l
+}
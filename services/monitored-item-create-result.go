@@ -0,0 +1,201 @@
+// Copyright 2018 gopcua authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package services
+
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/wmnsk/gopcua/datatypes"
+	"github.com/wmnsk/gopcua/errors"
+)
+
+// MonitoredItemCreateResult represents the result of creating a MonitoredItem.
+//
+// Specification: Part 4, 5.12.2.2
+type MonitoredItemCreateResult struct {
+	// StatusCode for the MonitoredItem to create, e.g. BadNodeIdUnknown.
+	StatusCode uint32
+
+	// Server-assigned id for the MonitoredItem.
+	MonitoredItemID         uint32
+	RevisedSamplingInterval float64
+	RevisedQueueSize        uint32
+	FilterResult            *datatypes.ExtensionObject
+}
+
+// NewMonitoredItemCreateResult creates a new MonitoredItemCreateResult.
+func NewMonitoredItemCreateResult(code uint32, id uint32, interval float64, queueSize uint32, filterResult *datatypes.ExtensionObject) *MonitoredItemCreateResult {
+	return &MonitoredItemCreateResult{
+		StatusCode:              code,
+		MonitoredItemID:         id,
+		RevisedSamplingInterval: interval,
+		RevisedQueueSize:        queueSize,
+		FilterResult:            filterResult,
+	}
+}
+
+// DecodeMonitoredItemCreateResult decodes given bytes into MonitoredItemCreateResult.
+func DecodeMonitoredItemCreateResult(b []byte) (*MonitoredItemCreateResult, error) {
+	m := &MonitoredItemCreateResult{}
+	if err := m.DecodeFromBytes(b); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// DecodeFromBytes decodes given bytes into MonitoredItemCreateResult.
+func (m *MonitoredItemCreateResult) DecodeFromBytes(b []byte) error {
+	offset := 0
+	m.StatusCode = binary.LittleEndian.Uint32(b[offset : offset+4])
+	offset += 4
+
+	m.MonitoredItemID = binary.LittleEndian.Uint32(b[offset : offset+4])
+	offset += 4
+
+	m.RevisedSamplingInterval = math.Float64frombits(binary.LittleEndian.Uint64(b[offset : offset+8]))
+	offset += 8
+
+	m.RevisedQueueSize = binary.LittleEndian.Uint32(b[offset : offset+4])
+	offset += 4
+
+	m.FilterResult = &datatypes.ExtensionObject{}
+	return m.FilterResult.DecodeFromBytes(b[offset:])
+}
+
+// Serialize serializes MonitoredItemCreateResult into bytes.
+func (m *MonitoredItemCreateResult) Serialize() ([]byte, error) {
+	b := make([]byte, m.Len())
+	if err := m.SerializeTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// SerializeTo serializes MonitoredItemCreateResult into bytes.
+func (m *MonitoredItemCreateResult) SerializeTo(b []byte) error {
+	offset := 0
+	binary.LittleEndian.PutUint32(b[offset:offset+4], m.StatusCode)
+	offset += 4
+
+	binary.LittleEndian.PutUint32(b[offset:offset+4], m.MonitoredItemID)
+	offset += 4
+
+	binary.LittleEndian.PutUint64(b[offset:offset+8], math.Float64bits(m.RevisedSamplingInterval))
+	offset += 8
+
+	binary.LittleEndian.PutUint32(b[offset:offset+4], m.RevisedQueueSize)
+	offset += 4
+
+	if m.FilterResult != nil {
+		return m.FilterResult.SerializeTo(b[offset:])
+	}
+
+	return nil
+}
+
+// Len returns the actual length of MonitoredItemCreateResult in int.
+func (m *MonitoredItemCreateResult) Len() int {
+	// StatusCode + MonitoredItemID + RevisedSamplingInterval + RevisedQueueSize
+	l := 20
+	if m.FilterResult != nil {
+		l += m.FilterResult.Len()
+	}
+
+	return l
+}
+
+// MonitoredItemCreateResultArray represents an array of MonitoredItemCreateResults.
+// It does not correspond to a certain type from the specification
+// but makes encoding and decoding easier.
+type MonitoredItemCreateResultArray struct {
+	ArraySize                  int32
+	MonitoredItemCreateResults []*MonitoredItemCreateResult
+}
+
+// NewMonitoredItemCreateResultArray creates a new MonitoredItemCreateResultArray from multiple MonitoredItemCreateResults.
+func NewMonitoredItemCreateResultArray(monitoredItemCreateResults []*MonitoredItemCreateResult) *MonitoredItemCreateResultArray {
+	if monitoredItemCreateResults == nil {
+		return &MonitoredItemCreateResultArray{
+			ArraySize: 0,
+		}
+	}
+
+	return &MonitoredItemCreateResultArray{
+		ArraySize:                  int32(len(monitoredItemCreateResults)),
+		MonitoredItemCreateResults: monitoredItemCreateResults,
+	}
+}
+
+// DecodeMonitoredItemCreateResultArray decodes given bytes into MonitoredItemCreateResultArray.
+func DecodeMonitoredItemCreateResultArray(b []byte) (*MonitoredItemCreateResultArray, error) {
+	m := &MonitoredItemCreateResultArray{}
+	if err := m.DecodeFromBytes(b); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// DecodeFromBytes decodes given bytes into MonitoredItemCreateResultArray.
+func (m *MonitoredItemCreateResultArray) DecodeFromBytes(b []byte) error {
+	if len(b) < 4 {
+		return errors.NewErrTooShortToDecode(m, "should be longer than 4 bytes")
+	}
+
+	m.ArraySize = int32(binary.LittleEndian.Uint32(b[:4]))
+	if m.ArraySize <= 0 {
+		return nil
+	}
+
+	offset := 4
+	for i := 1; i <= int(m.ArraySize); i++ {
+		monitoredItemCreateResult, err := DecodeMonitoredItemCreateResult(b[offset:])
+		if err != nil {
+			return err
+		}
+		m.MonitoredItemCreateResults = append(m.MonitoredItemCreateResults, monitoredItemCreateResult)
+		offset += monitoredItemCreateResult.Len()
+	}
+
+	return nil
+}
+
+// Serialize serializes MonitoredItemCreateResultArray into bytes.
+func (m *MonitoredItemCreateResultArray) Serialize() ([]byte, error) {
+	b := make([]byte, m.Len())
+	if err := m.SerializeTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// SerializeTo serializes MonitoredItemCreateResultArray into bytes.
+func (m *MonitoredItemCreateResultArray) SerializeTo(b []byte) error {
+	offset := 4
+	binary.LittleEndian.PutUint32(b[:4], uint32(m.ArraySize))
+
+	for _, monitoredItemCreateResult := range m.MonitoredItemCreateResults {
+		if err := monitoredItemCreateResult.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += monitoredItemCreateResult.Len()
+	}
+
+	return nil
+}
+
+// Len returns the actual length of MonitoredItemCreateResultArray in int.
+func (m *MonitoredItemCreateResultArray) Len() int {
+	l := 4
+	for _, monitoredItemCreateResult := range m.MonitoredItemCreateResults {
+		l += monitoredItemCreateResult.Len()
+	}
+
+	return l
+}
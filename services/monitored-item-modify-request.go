@@ -0,0 +1,173 @@
+// Copyright 2018 gopcua authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package services
+
+import (
+	"encoding/binary"
+
+	"github.com/wmnsk/gopcua/errors"
+)
+
+// MonitoredItemModifyRequest represents a MonitoredItem to be modified in ModifyMonitoredItemsRequest.
+//
+// Specification: Part 4, 5.12.3.2
+type MonitoredItemModifyRequest struct {
+	// Server-assigned id for the MonitoredItem.
+	MonitoredItemID     uint32
+	RequestedParameters *MonitoringParameters
+}
+
+// NewMonitoredItemModifyRequest creates a new MonitoredItemModifyRequest.
+func NewMonitoredItemModifyRequest(id uint32, params *MonitoringParameters) *MonitoredItemModifyRequest {
+	return &MonitoredItemModifyRequest{
+		MonitoredItemID:     id,
+		RequestedParameters: params,
+	}
+}
+
+// DecodeMonitoredItemModifyRequest decodes given bytes into MonitoredItemModifyRequest.
+func DecodeMonitoredItemModifyRequest(b []byte) (*MonitoredItemModifyRequest, error) {
+	m := &MonitoredItemModifyRequest{}
+	if err := m.DecodeFromBytes(b); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// DecodeFromBytes decodes given bytes into MonitoredItemModifyRequest.
+func (m *MonitoredItemModifyRequest) DecodeFromBytes(b []byte) error {
+	offset := 0
+	m.MonitoredItemID = binary.LittleEndian.Uint32(b[offset : offset+4])
+	offset += 4
+
+	m.RequestedParameters = &MonitoringParameters{}
+	return m.RequestedParameters.DecodeFromBytes(b[offset:])
+}
+
+// Serialize serializes MonitoredItemModifyRequest into bytes.
+func (m *MonitoredItemModifyRequest) Serialize() ([]byte, error) {
+	b := make([]byte, m.Len())
+	if err := m.SerializeTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// SerializeTo serializes MonitoredItemModifyRequest into bytes.
+func (m *MonitoredItemModifyRequest) SerializeTo(b []byte) error {
+	offset := 0
+	binary.LittleEndian.PutUint32(b[offset:offset+4], m.MonitoredItemID)
+	offset += 4
+
+	if m.RequestedParameters != nil {
+		return m.RequestedParameters.SerializeTo(b[offset:])
+	}
+
+	return nil
+}
+
+// Len returns the actual length of MonitoredItemModifyRequest in int.
+func (m *MonitoredItemModifyRequest) Len() int {
+	// MonitoredItemID
+	l := 4
+	if m.RequestedParameters != nil {
+		l += m.RequestedParameters.Len()
+	}
+
+	return l
+}
+
+// MonitoredItemModifyRequestArray represents an array of MonitoredItemModifyRequests.
+// It does not correspond to a certain type from the specification
+// but makes encoding and decoding easier.
+type MonitoredItemModifyRequestArray struct {
+	ArraySize                   int32
+	MonitoredItemModifyRequests []*MonitoredItemModifyRequest
+}
+
+// NewMonitoredItemModifyRequestArray creates a new MonitoredItemModifyRequestArray from multiple MonitoredItemModifyRequests.
+func NewMonitoredItemModifyRequestArray(monitoredItemModifyRequests []*MonitoredItemModifyRequest) *MonitoredItemModifyRequestArray {
+	if monitoredItemModifyRequests == nil {
+		return &MonitoredItemModifyRequestArray{
+			ArraySize: 0,
+		}
+	}
+
+	return &MonitoredItemModifyRequestArray{
+		ArraySize:                   int32(len(monitoredItemModifyRequests)),
+		MonitoredItemModifyRequests: monitoredItemModifyRequests,
+	}
+}
+
+// DecodeMonitoredItemModifyRequestArray decodes given bytes into MonitoredItemModifyRequestArray.
+func DecodeMonitoredItemModifyRequestArray(b []byte) (*MonitoredItemModifyRequestArray, error) {
+	m := &MonitoredItemModifyRequestArray{}
+	if err := m.DecodeFromBytes(b); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// DecodeFromBytes decodes given bytes into MonitoredItemModifyRequestArray.
+func (m *MonitoredItemModifyRequestArray) DecodeFromBytes(b []byte) error {
+	if len(b) < 4 {
+		return errors.NewErrTooShortToDecode(m, "should be longer than 4 bytes")
+	}
+
+	m.ArraySize = int32(binary.LittleEndian.Uint32(b[:4]))
+	if m.ArraySize <= 0 {
+		return nil
+	}
+
+	offset := 4
+	for i := 1; i <= int(m.ArraySize); i++ {
+		monitoredItemModifyRequest, err := DecodeMonitoredItemModifyRequest(b[offset:])
+		if err != nil {
+			return err
+		}
+		m.MonitoredItemModifyRequests = append(m.MonitoredItemModifyRequests, monitoredItemModifyRequest)
+		offset += monitoredItemModifyRequest.Len()
+	}
+
+	return nil
+}
+
+// Serialize serializes MonitoredItemModifyRequestArray into bytes.
+func (m *MonitoredItemModifyRequestArray) Serialize() ([]byte, error) {
+	b := make([]byte, m.Len())
+	if err := m.SerializeTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// SerializeTo serializes MonitoredItemModifyRequestArray into bytes.
+func (m *MonitoredItemModifyRequestArray) SerializeTo(b []byte) error {
+	offset := 4
+	binary.LittleEndian.PutUint32(b[:4], uint32(m.ArraySize))
+
+	for _, monitoredItemModifyRequest := range m.MonitoredItemModifyRequests {
+		if err := monitoredItemModifyRequest.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += monitoredItemModifyRequest.Len()
+	}
+
+	return nil
+}
+
+// Len returns the actual length of MonitoredItemModifyRequestArray in int.
+func (m *MonitoredItemModifyRequestArray) Len() int {
+	l := 4
+	for _, monitoredItemModifyRequest := range m.MonitoredItemModifyRequests {
+		l += monitoredItemModifyRequest.Len()
+	}
+
+	return l
+}
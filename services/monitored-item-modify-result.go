@@ -0,0 +1,191 @@
+// Copyright 2018 gopcua authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package services
+
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/wmnsk/gopcua/datatypes"
+	"github.com/wmnsk/gopcua/errors"
+)
+
+// MonitoredItemModifyResult represents the result of modifying a MonitoredItem.
+//
+// Specification: Part 4, 5.12.3.2
+type MonitoredItemModifyResult struct {
+	// StatusCode for the MonitoredItem to modify, e.g. BadMonitoredItemIdInvalid.
+	StatusCode              uint32
+	RevisedSamplingInterval float64
+	RevisedQueueSize        uint32
+	FilterResult            *datatypes.ExtensionObject
+}
+
+// NewMonitoredItemModifyResult creates a new MonitoredItemModifyResult.
+func NewMonitoredItemModifyResult(code uint32, interval float64, queueSize uint32, filterResult *datatypes.ExtensionObject) *MonitoredItemModifyResult {
+	return &MonitoredItemModifyResult{
+		StatusCode:              code,
+		RevisedSamplingInterval: interval,
+		RevisedQueueSize:        queueSize,
+		FilterResult:            filterResult,
+	}
+}
+
+// DecodeMonitoredItemModifyResult decodes given bytes into MonitoredItemModifyResult.
+func DecodeMonitoredItemModifyResult(b []byte) (*MonitoredItemModifyResult, error) {
+	m := &MonitoredItemModifyResult{}
+	if err := m.DecodeFromBytes(b); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// DecodeFromBytes decodes given bytes into MonitoredItemModifyResult.
+func (m *MonitoredItemModifyResult) DecodeFromBytes(b []byte) error {
+	offset := 0
+	m.StatusCode = binary.LittleEndian.Uint32(b[offset : offset+4])
+	offset += 4
+
+	m.RevisedSamplingInterval = math.Float64frombits(binary.LittleEndian.Uint64(b[offset : offset+8]))
+	offset += 8
+
+	m.RevisedQueueSize = binary.LittleEndian.Uint32(b[offset : offset+4])
+	offset += 4
+
+	m.FilterResult = &datatypes.ExtensionObject{}
+	return m.FilterResult.DecodeFromBytes(b[offset:])
+}
+
+// Serialize serializes MonitoredItemModifyResult into bytes.
+func (m *MonitoredItemModifyResult) Serialize() ([]byte, error) {
+	b := make([]byte, m.Len())
+	if err := m.SerializeTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// SerializeTo serializes MonitoredItemModifyResult into bytes.
+func (m *MonitoredItemModifyResult) SerializeTo(b []byte) error {
+	offset := 0
+	binary.LittleEndian.PutUint32(b[offset:offset+4], m.StatusCode)
+	offset += 4
+
+	binary.LittleEndian.PutUint64(b[offset:offset+8], math.Float64bits(m.RevisedSamplingInterval))
+	offset += 8
+
+	binary.LittleEndian.PutUint32(b[offset:offset+4], m.RevisedQueueSize)
+	offset += 4
+
+	if m.FilterResult != nil {
+		return m.FilterResult.SerializeTo(b[offset:])
+	}
+
+	return nil
+}
+
+// Len returns the actual length of MonitoredItemModifyResult in int.
+func (m *MonitoredItemModifyResult) Len() int {
+	// StatusCode + RevisedSamplingInterval + RevisedQueueSize
+	l := 16
+	if m.FilterResult != nil {
+		l += m.FilterResult.Len()
+	}
+
+	return l
+}
+
+// MonitoredItemModifyResultArray represents an array of MonitoredItemModifyResults.
+// It does not correspond to a certain type from the specification
+// but makes encoding and decoding easier.
+type MonitoredItemModifyResultArray struct {
+	ArraySize                  int32
+	MonitoredItemModifyResults []*MonitoredItemModifyResult
+}
+
+// NewMonitoredItemModifyResultArray creates a new MonitoredItemModifyResultArray from multiple MonitoredItemModifyResults.
+func NewMonitoredItemModifyResultArray(monitoredItemModifyResults []*MonitoredItemModifyResult) *MonitoredItemModifyResultArray {
+	if monitoredItemModifyResults == nil {
+		return &MonitoredItemModifyResultArray{
+			ArraySize: 0,
+		}
+	}
+
+	return &MonitoredItemModifyResultArray{
+		ArraySize:                  int32(len(monitoredItemModifyResults)),
+		MonitoredItemModifyResults: monitoredItemModifyResults,
+	}
+}
+
+// DecodeMonitoredItemModifyResultArray decodes given bytes into MonitoredItemModifyResultArray.
+func DecodeMonitoredItemModifyResultArray(b []byte) (*MonitoredItemModifyResultArray, error) {
+	m := &MonitoredItemModifyResultArray{}
+	if err := m.DecodeFromBytes(b); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// DecodeFromBytes decodes given bytes into MonitoredItemModifyResultArray.
+func (m *MonitoredItemModifyResultArray) DecodeFromBytes(b []byte) error {
+	if len(b) < 4 {
+		return errors.NewErrTooShortToDecode(m, "should be longer than 4 bytes")
+	}
+
+	m.ArraySize = int32(binary.LittleEndian.Uint32(b[:4]))
+	if m.ArraySize <= 0 {
+		return nil
+	}
+
+	offset := 4
+	for i := 1; i <= int(m.ArraySize); i++ {
+		monitoredItemModifyResult, err := DecodeMonitoredItemModifyResult(b[offset:])
+		if err != nil {
+			return err
+		}
+		m.MonitoredItemModifyResults = append(m.MonitoredItemModifyResults, monitoredItemModifyResult)
+		offset += monitoredItemModifyResult.Len()
+	}
+
+	return nil
+}
+
+// Serialize serializes MonitoredItemModifyResultArray into bytes.
+func (m *MonitoredItemModifyResultArray) Serialize() ([]byte, error) {
+	b := make([]byte, m.Len())
+	if err := m.SerializeTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// SerializeTo serializes MonitoredItemModifyResultArray into bytes.
+func (m *MonitoredItemModifyResultArray) SerializeTo(b []byte) error {
+	offset := 4
+	binary.LittleEndian.PutUint32(b[:4], uint32(m.ArraySize))
+
+	for _, monitoredItemModifyResult := range m.MonitoredItemModifyResults {
+		if err := monitoredItemModifyResult.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += monitoredItemModifyResult.Len()
+	}
+
+	return nil
+}
+
+// Len returns the actual length of MonitoredItemModifyResultArray in int.
+func (m *MonitoredItemModifyResultArray) Len() int {
+	l := 4
+	for _, monitoredItemModifyResult := range m.MonitoredItemModifyResults {
+		l += monitoredItemModifyResult.Len()
+	}
+
+	return l
+}
@@ -0,0 +1,144 @@
+// Copyright 2018 gopcua authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package services
+
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/wmnsk/gopcua/datatypes"
+)
+
+// MonitoringMode is an enumeration that specifies whether sampling and reporting
+// are enabled or disabled for a MonitoredItem.
+//
+// Specification: Part 4, 7.18
+type MonitoringMode uint32
+
+// MonitoringMode definitions.
+const (
+	// The item being monitored is not sampled or evaluated, and Notifications are not
+	// generated or queued. Notification reporting is disabled.
+	MonitoringModeDisabled MonitoringMode = iota
+
+	// The item being monitored is sampled and evaluated, and Notifications are generated
+	// and queued. Notification reporting is disabled.
+	MonitoringModeSampling
+
+	// The item being monitored is sampled and evaluated, and Notifications are generated
+	// and queued. Notification reporting is enabled.
+	MonitoringModeReporting
+)
+
+// MonitoringParameters represents the parameters to define the monitoring characteristics
+// of a MonitoredItem.
+//
+// Specification: Part 4, 7.16
+type MonitoringParameters struct {
+	// Client-supplied id of the MonitoredItem.
+	// This id is used in Notifications generated for the MonitoredItem.
+	ClientHandle uint32
+
+	// The interval in milliseconds that defines the fastest rate at which
+	// the MonitoredItem should be accessed and evaluated.
+	// -1 means to use the publishing interval of the Subscription.
+	SamplingInterval float64
+	Filter           *datatypes.ExtensionObject
+	QueueSize        uint32
+	DiscardOldest    *datatypes.Boolean
+}
+
+// NewMonitoringParameters creates a new MonitoringParameters.
+func NewMonitoringParameters(handle uint32, interval float64, filter *datatypes.ExtensionObject, queueSize uint32, discardOldest bool) *MonitoringParameters {
+	return &MonitoringParameters{
+		ClientHandle:     handle,
+		SamplingInterval: interval,
+		Filter:           filter,
+		QueueSize:        queueSize,
+		DiscardOldest:    datatypes.NewBoolean(discardOldest),
+	}
+}
+
+// DecodeMonitoringParameters decodes given bytes into MonitoringParameters.
+func DecodeMonitoringParameters(b []byte) (*MonitoringParameters, error) {
+	m := &MonitoringParameters{}
+	if err := m.DecodeFromBytes(b); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// DecodeFromBytes decodes given bytes into MonitoringParameters.
+func (m *MonitoringParameters) DecodeFromBytes(b []byte) error {
+	offset := 0
+	m.ClientHandle = binary.LittleEndian.Uint32(b[offset : offset+4])
+	offset += 4
+
+	m.SamplingInterval = math.Float64frombits(binary.LittleEndian.Uint64(b[offset : offset+8]))
+	offset += 8
+
+	m.Filter = &datatypes.ExtensionObject{}
+	if err := m.Filter.DecodeFromBytes(b[offset:]); err != nil {
+		return err
+	}
+	offset += m.Filter.Len()
+
+	m.QueueSize = binary.LittleEndian.Uint32(b[offset : offset+4])
+	offset += 4
+
+	m.DiscardOldest = &datatypes.Boolean{}
+	return m.DiscardOldest.DecodeFromBytes(b[offset:])
+}
+
+// Serialize serializes MonitoringParameters into bytes.
+func (m *MonitoringParameters) Serialize() ([]byte, error) {
+	b := make([]byte, m.Len())
+	if err := m.SerializeTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// SerializeTo serializes MonitoringParameters into bytes.
+func (m *MonitoringParameters) SerializeTo(b []byte) error {
+	offset := 0
+	binary.LittleEndian.PutUint32(b[offset:offset+4], m.ClientHandle)
+	offset += 4
+
+	binary.LittleEndian.PutUint64(b[offset:offset+8], math.Float64bits(m.SamplingInterval))
+	offset += 8
+
+	if m.Filter != nil {
+		if err := m.Filter.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += m.Filter.Len()
+	}
+
+	binary.LittleEndian.PutUint32(b[offset:offset+4], m.QueueSize)
+	offset += 4
+
+	if m.DiscardOldest != nil {
+		return m.DiscardOldest.SerializeTo(b[offset:])
+	}
+
+	return nil
+}
+
+// Len returns the actual length of MonitoringParameters in int.
+func (m *MonitoringParameters) Len() int {
+	// ClientHandle + SamplingInterval + QueueSize
+	l := 16
+	if m.Filter != nil {
+		l += m.Filter.Len()
+	}
+	if m.DiscardOldest != nil {
+		l += m.DiscardOldest.Len()
+	}
+
+	return l
+}
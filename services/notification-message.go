@@ -0,0 +1,89 @@
+// Copyright 2018 gopcua authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package services
+
+import (
+	"encoding/binary"
+	"time"
+
+	"github.com/wmnsk/gopcua/datatypes"
+	"github.com/wmnsk/gopcua/errors"
+	"github.com/wmnsk/gopcua/utils"
+)
+
+// NotificationMessage contains a list of NotificationData sent in PublishResponse
+// or RepublishResponse. Each NotificationData is an ExtensionObject that contains
+// the Notifications of a certain type, e.g. DataChangeNotification.
+//
+// Specification: Part 4, 7.21
+type NotificationMessage struct {
+	SequenceNumber   uint32
+	PublishTime      time.Time
+	NotificationData *datatypes.ExtensionObjectArray
+}
+
+// NewNotificationMessage creates a new NotificationMessage.
+func NewNotificationMessage(seq uint32, publishTime time.Time, data ...*datatypes.ExtensionObject) *NotificationMessage {
+	return &NotificationMessage{
+		SequenceNumber:   seq,
+		PublishTime:      publishTime,
+		NotificationData: datatypes.NewExtensionObjectArray(data),
+	}
+}
+
+// DecodeNotificationMessage decodes given bytes into NotificationMessage.
+func DecodeNotificationMessage(b []byte) (*NotificationMessage, error) {
+	n := &NotificationMessage{}
+	if err := n.DecodeFromBytes(b); err != nil {
+		return nil, err
+	}
+
+	return n, nil
+}
+
+// DecodeFromBytes decodes given bytes into NotificationMessage.
+func (n *NotificationMessage) DecodeFromBytes(b []byte) error {
+	if len(b) < 16 {
+		return errors.NewErrTooShortToDecode(n, "should be longer than 16 bytes")
+	}
+	n.SequenceNumber = binary.LittleEndian.Uint32(b[:4])
+	n.PublishTime = utils.DecodeTimestamp(b[4:12])
+
+	n.NotificationData = &datatypes.ExtensionObjectArray{}
+	return n.NotificationData.DecodeFromBytes(b[12:])
+}
+
+// Serialize serializes NotificationMessage into bytes.
+func (n *NotificationMessage) Serialize() ([]byte, error) {
+	b := make([]byte, n.Len())
+	if err := n.SerializeTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// SerializeTo serializes NotificationMessage into bytes.
+func (n *NotificationMessage) SerializeTo(b []byte) error {
+	binary.LittleEndian.PutUint32(b[:4], n.SequenceNumber)
+	utils.EncodeTimestamp(b[4:12], n.PublishTime)
+
+	if n.NotificationData != nil {
+		return n.NotificationData.SerializeTo(b[12:])
+	}
+
+	return nil
+}
+
+// Len returns the actual length of NotificationMessage in int.
+func (n *NotificationMessage) Len() int {
+	// SequenceNumber + PublishTime
+	l := 12
+	if n.NotificationData != nil {
+		l += n.NotificationData.Len()
+	}
+
+	return l
+}
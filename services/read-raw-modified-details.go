@@ -0,0 +1,143 @@
+// Copyright 2018 gopcua authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package services
+
+import (
+	"encoding/binary"
+	"time"
+
+	"github.com/wmnsk/gopcua/datatypes"
+	"github.com/wmnsk/gopcua/errors"
+	"github.com/wmnsk/gopcua/id"
+	"github.com/wmnsk/gopcua/utils"
+)
+
+func init() {
+	datatypes.RegisterExtensionObject(
+		datatypes.NewFourByteNodeID(0, id.ReadRawModifiedDetails_Encoding_DefaultBinary),
+		func() datatypes.Data { return &ReadRawModifiedDetails{} },
+	)
+}
+
+// ReadRawModifiedDetails is used to select raw or modified historical values
+// in a HistoryReadRequest. It is sent as the body of an ExtensionObject, which
+// is created by ExtensionObject method.
+//
+// Specification: Part 11, 6.4.3
+type ReadRawModifiedDetails struct {
+	IsReadModified   *datatypes.Boolean
+	StartTime        time.Time
+	EndTime          time.Time
+	NumValuesPerNode uint32
+	ReturnBounds     *datatypes.Boolean
+}
+
+// NewReadRawModifiedDetails creates a new ReadRawModifiedDetails.
+func NewReadRawModifiedDetails(isReadModified bool, start, end time.Time, numValues uint32, returnBounds bool) *ReadRawModifiedDetails {
+	return &ReadRawModifiedDetails{
+		IsReadModified:   datatypes.NewBoolean(isReadModified),
+		StartTime:        start,
+		EndTime:          end,
+		NumValuesPerNode: numValues,
+		ReturnBounds:     datatypes.NewBoolean(returnBounds),
+	}
+}
+
+// DecodeReadRawModifiedDetails decodes given bytes into ReadRawModifiedDetails.
+func DecodeReadRawModifiedDetails(b []byte) (*ReadRawModifiedDetails, error) {
+	r := &ReadRawModifiedDetails{}
+	if err := r.DecodeFromBytes(b); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// DecodeFromBytes decodes given bytes into ReadRawModifiedDetails.
+func (r *ReadRawModifiedDetails) DecodeFromBytes(b []byte) error {
+	if len(b) < 22 {
+		return errors.NewErrTooShortToDecode(r, "should be longer than 22 bytes")
+	}
+
+	r.IsReadModified = &datatypes.Boolean{}
+	if err := r.IsReadModified.DecodeFromBytes(b); err != nil {
+		return err
+	}
+	offset := r.IsReadModified.Len()
+
+	r.StartTime = utils.DecodeTimestamp(b[offset : offset+8])
+	offset += 8
+	r.EndTime = utils.DecodeTimestamp(b[offset : offset+8])
+	offset += 8
+
+	r.NumValuesPerNode = binary.LittleEndian.Uint32(b[offset : offset+4])
+	offset += 4
+
+	r.ReturnBounds = &datatypes.Boolean{}
+	return r.ReturnBounds.DecodeFromBytes(b[offset:])
+}
+
+// Serialize serializes ReadRawModifiedDetails into bytes.
+func (r *ReadRawModifiedDetails) Serialize() ([]byte, error) {
+	b := make([]byte, r.Len())
+	if err := r.SerializeTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// SerializeTo serializes ReadRawModifiedDetails into bytes.
+func (r *ReadRawModifiedDetails) SerializeTo(b []byte) error {
+	offset := 0
+	if r.IsReadModified != nil {
+		if err := r.IsReadModified.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+	}
+	offset++
+
+	utils.EncodeTimestamp(b[offset:offset+8], r.StartTime)
+	offset += 8
+	utils.EncodeTimestamp(b[offset:offset+8], r.EndTime)
+	offset += 8
+
+	binary.LittleEndian.PutUint32(b[offset:offset+4], r.NumValuesPerNode)
+	offset += 4
+
+	if r.ReturnBounds != nil {
+		return r.ReturnBounds.SerializeTo(b[offset:])
+	}
+
+	return nil
+}
+
+// Len returns the actual length of ReadRawModifiedDetails in int.
+func (r *ReadRawModifiedDetails) Len() int {
+	// IsReadModified + StartTime + EndTime + NumValuesPerNode + ReturnBounds
+	return 1 + 8 + 8 + 4 + 1
+}
+
+// DataType returns type of Data.
+func (r *ReadRawModifiedDetails) DataType() uint16 {
+	return id.Structure
+}
+
+// ExtensionObject returns ReadRawModifiedDetails in ExtensionObject, which can be used
+// as HistoryReadDetails in HistoryReadRequest.
+func (r *ReadRawModifiedDetails) ExtensionObject() *datatypes.ExtensionObject {
+	e := &datatypes.ExtensionObject{
+		TypeID: datatypes.NewExpandedNodeID(
+			false, false,
+			datatypes.NewFourByteNodeID(0, id.ReadRawModifiedDetails_Encoding_DefaultBinary),
+			"", 0,
+		),
+		EncodingMask: 0x01,
+		Value:        r,
+	}
+	e.SetLength()
+
+	return e
+}
@@ -6,6 +6,7 @@ package services
 
 import (
 	"encoding/binary"
+	"math"
 	"time"
 
 	"github.com/wmnsk/gopcua/datatypes"
@@ -34,6 +35,15 @@ const (
 	TimestampsToReturnNeither
 )
 
+// MaxAge values with the special meaning in ReadRequest.
+const (
+	// MaxAgeNoCache requests the Server to read a new value from the data source.
+	MaxAgeNoCache float64 = 0
+
+	// MaxAgeCached requests the Server to return a cached value if it has one.
+	MaxAgeCached float64 = math.MaxInt32
+)
+
 // ReadRequest is used to read one or more Attributes of one or more Nodes.
 // For constructed Attribute values whose elements are indexed, such as an array,
 // this Service allows Clients to read the entire set of indexed values as a composite,
@@ -72,7 +82,7 @@ type ReadRequest struct {
 	// a cached value.
 	//
 	// Negative values are invalid for maxAge.
-	MaxAge uint64
+	MaxAge float64
 
 	// An enumeration that specifies the Timestamps to be returned for each requested
 	// Variable Value Attribute.
@@ -84,7 +94,7 @@ type ReadRequest struct {
 }
 
 // NewReadRequest creates a new ReadRequest.
-func NewReadRequest(ts time.Time, authToken datatypes.NodeID, handle, diag, timeout uint32, auditID string, maxAge uint64, tsRet TimestampsToReturn, nodes []*datatypes.ReadValueID) *ReadRequest {
+func NewReadRequest(ts time.Time, authToken datatypes.NodeID, handle, diag, timeout uint32, auditID string, maxAge float64, tsRet TimestampsToReturn, nodes []*datatypes.ReadValueID) *ReadRequest {
 	return &ReadRequest{
 		TypeID: datatypes.NewExpandedNodeID(
 			false, false,
@@ -143,7 +153,7 @@ func (r *ReadRequest) DecodeFromBytes(b []byte) error {
 	offset += r.RequestHeader.Len() - len(r.RequestHeader.Payload)
 
 	// max age
-	r.MaxAge = binary.LittleEndian.Uint64(b[offset : offset+8])
+	r.MaxAge = math.Float64frombits(binary.LittleEndian.Uint64(b[offset : offset+8]))
 	offset += 8
 
 	// timestamps to return
@@ -186,7 +196,7 @@ func (r *ReadRequest) SerializeTo(b []byte) error {
 	offset += r.RequestHeader.Len()
 
 	// max age
-	binary.LittleEndian.PutUint64(b[offset:offset+8], r.MaxAge)
+	binary.LittleEndian.PutUint64(b[offset:offset+8], math.Float64bits(r.MaxAge))
 	offset += 8
 
 	// timestamps to return
@@ -0,0 +1,263 @@
+// Copyright 2018 gopcua authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package services
+
+import (
+	"encoding/binary"
+
+	"github.com/wmnsk/gopcua/datatypes"
+	"github.com/wmnsk/gopcua/errors"
+)
+
+// ReferenceDescription describes a Reference returned in BrowseResult.
+//
+// Specification: Part 4, 7.25
+type ReferenceDescription struct {
+	ReferenceTypeID datatypes.NodeID
+	IsForward       *datatypes.Boolean
+	NodeID          *datatypes.ExpandedNodeID
+	BrowseName      *datatypes.QualifiedName
+	DisplayName     *datatypes.LocalizedText
+	NodeClass       NodeClass
+	TypeDefinition  *datatypes.ExpandedNodeID
+}
+
+// NewReferenceDescription creates a new ReferenceDescription.
+func NewReferenceDescription(refTypeID datatypes.NodeID, isForward bool, nodeID *datatypes.ExpandedNodeID, browseName *datatypes.QualifiedName, displayName *datatypes.LocalizedText, nodeClass NodeClass, typeDef *datatypes.ExpandedNodeID) *ReferenceDescription {
+	return &ReferenceDescription{
+		ReferenceTypeID: refTypeID,
+		IsForward:       datatypes.NewBoolean(isForward),
+		NodeID:          nodeID,
+		BrowseName:      browseName,
+		DisplayName:     displayName,
+		NodeClass:       nodeClass,
+		TypeDefinition:  typeDef,
+	}
+}
+
+// DecodeReferenceDescription decodes given bytes into ReferenceDescription.
+func DecodeReferenceDescription(b []byte) (*ReferenceDescription, error) {
+	r := &ReferenceDescription{}
+	if err := r.DecodeFromBytes(b); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// DecodeFromBytes decodes given bytes into ReferenceDescription.
+func (r *ReferenceDescription) DecodeFromBytes(b []byte) error {
+	referenceTypeID, err := datatypes.DecodeNodeID(b)
+	if err != nil {
+		return err
+	}
+	r.ReferenceTypeID = referenceTypeID
+	offset := r.ReferenceTypeID.Len()
+
+	r.IsForward = &datatypes.Boolean{}
+	if err := r.IsForward.DecodeFromBytes(b[offset:]); err != nil {
+		return err
+	}
+	offset += r.IsForward.Len()
+
+	r.NodeID = &datatypes.ExpandedNodeID{}
+	if err := r.NodeID.DecodeFromBytes(b[offset:]); err != nil {
+		return err
+	}
+	offset += r.NodeID.Len()
+
+	r.BrowseName = &datatypes.QualifiedName{}
+	if err := r.BrowseName.DecodeFromBytes(b[offset:]); err != nil {
+		return err
+	}
+	offset += r.BrowseName.Len()
+
+	r.DisplayName = &datatypes.LocalizedText{}
+	if err := r.DisplayName.DecodeFromBytes(b[offset:]); err != nil {
+		return err
+	}
+	offset += r.DisplayName.Len()
+
+	r.NodeClass = NodeClass(binary.LittleEndian.Uint32(b[offset : offset+4]))
+	offset += 4
+
+	r.TypeDefinition = &datatypes.ExpandedNodeID{}
+	return r.TypeDefinition.DecodeFromBytes(b[offset:])
+}
+
+// Serialize serializes ReferenceDescription into bytes.
+func (r *ReferenceDescription) Serialize() ([]byte, error) {
+	b := make([]byte, r.Len())
+	if err := r.SerializeTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// SerializeTo serializes ReferenceDescription into bytes.
+func (r *ReferenceDescription) SerializeTo(b []byte) error {
+	offset := 0
+	if r.ReferenceTypeID != nil {
+		if err := r.ReferenceTypeID.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += r.ReferenceTypeID.Len()
+	}
+
+	if r.IsForward != nil {
+		if err := r.IsForward.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += r.IsForward.Len()
+	}
+
+	if r.NodeID != nil {
+		if err := r.NodeID.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += r.NodeID.Len()
+	}
+
+	if r.BrowseName != nil {
+		if err := r.BrowseName.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += r.BrowseName.Len()
+	}
+
+	if r.DisplayName != nil {
+		if err := r.DisplayName.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += r.DisplayName.Len()
+	}
+
+	binary.LittleEndian.PutUint32(b[offset:offset+4], uint32(r.NodeClass))
+	offset += 4
+
+	if r.TypeDefinition != nil {
+		return r.TypeDefinition.SerializeTo(b[offset:])
+	}
+
+	return nil
+}
+
+// Len returns the actual length of ReferenceDescription in int.
+func (r *ReferenceDescription) Len() int {
+	// NodeClass
+	l := 4
+	if r.ReferenceTypeID != nil {
+		l += r.ReferenceTypeID.Len()
+	}
+	if r.IsForward != nil {
+		l += r.IsForward.Len()
+	}
+	if r.NodeID != nil {
+		l += r.NodeID.Len()
+	}
+	if r.BrowseName != nil {
+		l += r.BrowseName.Len()
+	}
+	if r.DisplayName != nil {
+		l += r.DisplayName.Len()
+	}
+	if r.TypeDefinition != nil {
+		l += r.TypeDefinition.Len()
+	}
+
+	return l
+}
+
+// ReferenceDescriptionArray represents an array of ReferenceDescriptions.
+// It does not correspond to a certain type from the specification
+// but makes encoding and decoding easier.
+type ReferenceDescriptionArray struct {
+	ArraySize  int32
+	References []*ReferenceDescription
+}
+
+// NewReferenceDescriptionArray creates a new ReferenceDescriptionArray from multiple ReferenceDescriptions.
+func NewReferenceDescriptionArray(references []*ReferenceDescription) *ReferenceDescriptionArray {
+	if references == nil {
+		return &ReferenceDescriptionArray{
+			ArraySize: 0,
+		}
+	}
+
+	return &ReferenceDescriptionArray{
+		ArraySize:  int32(len(references)),
+		References: references,
+	}
+}
+
+// DecodeReferenceDescriptionArray decodes given bytes into ReferenceDescriptionArray.
+func DecodeReferenceDescriptionArray(b []byte) (*ReferenceDescriptionArray, error) {
+	r := &ReferenceDescriptionArray{}
+	if err := r.DecodeFromBytes(b); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// DecodeFromBytes decodes given bytes into ReferenceDescriptionArray.
+func (r *ReferenceDescriptionArray) DecodeFromBytes(b []byte) error {
+	if len(b) < 4 {
+		return errors.NewErrTooShortToDecode(r, "should be longer than 4 bytes")
+	}
+
+	r.ArraySize = int32(binary.LittleEndian.Uint32(b[:4]))
+	if r.ArraySize <= 0 {
+		return nil
+	}
+
+	offset := 4
+	for i := 1; i <= int(r.ArraySize); i++ {
+		referenceDescription, err := DecodeReferenceDescription(b[offset:])
+		if err != nil {
+			return err
+		}
+		r.References = append(r.References, referenceDescription)
+		offset += referenceDescription.Len()
+	}
+
+	return nil
+}
+
+// Serialize serializes ReferenceDescriptionArray into bytes.
+func (r *ReferenceDescriptionArray) Serialize() ([]byte, error) {
+	b := make([]byte, r.Len())
+	if err := r.SerializeTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// SerializeTo serializes ReferenceDescriptionArray into bytes.
+func (r *ReferenceDescriptionArray) SerializeTo(b []byte) error {
+	offset := 4
+	binary.LittleEndian.PutUint32(b[:4], uint32(r.ArraySize))
+
+	for _, referenceDescription := range r.References {
+		if err := referenceDescription.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += referenceDescription.Len()
+	}
+
+	return nil
+}
+
+// Len returns the actual length of ReferenceDescriptionArray in int.
+func (r *ReferenceDescriptionArray) Len() int {
+	l := 4
+	for _, referenceDescription := range r.References {
+		l += referenceDescription.Len()
+	}
+
+	return l
+}
@@ -0,0 +1,137 @@
+// Copyright 2018 gopcua authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package services
+
+import (
+	"time"
+
+	"github.com/wmnsk/gopcua/datatypes"
+)
+
+// RegisterNodesRequest is used by Clients to register the Nodes that they know
+// they will access repeatedly (e.g. Write, Call). It allows Servers to set up
+// anything needed so that the access operations will be more efficient.
+//
+// Specification: Part 4, 5.8.5.2
+type RegisterNodesRequest struct {
+	TypeID *datatypes.ExpandedNodeID
+	*RequestHeader
+	NodesToRegister *datatypes.NodeIDArray
+}
+
+// NewRegisterNodesRequest creates a new RegisterNodesRequest.
+func NewRegisterNodesRequest(ts time.Time, authToken datatypes.NodeID, handle, diag, timeout uint32, auditID string, nodes []datatypes.NodeID) *RegisterNodesRequest {
+	return &RegisterNodesRequest{
+		TypeID: datatypes.NewExpandedNodeID(
+			false, false,
+			datatypes.NewFourByteNodeID(
+				0, ServiceTypeRegisterNodesRequest,
+			),
+			"", 0,
+		),
+		RequestHeader: NewRequestHeader(
+			authToken,
+			ts,
+			handle,
+			diag,
+			timeout,
+			auditID,
+			NewAdditionalHeader(
+				datatypes.NewExpandedNodeID(
+					false, false,
+					datatypes.NewTwoByteNodeID(0),
+					"", 0,
+				),
+				0x00,
+			),
+			nil,
+		),
+		NodesToRegister: datatypes.NewNodeIDArray(nodes),
+	}
+}
+
+// DecodeRegisterNodesRequest decodes given bytes into RegisterNodesRequest.
+func DecodeRegisterNodesRequest(b []byte) (*RegisterNodesRequest, error) {
+	r := &RegisterNodesRequest{}
+	if err := r.DecodeFromBytes(b); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// DecodeFromBytes decodes given bytes into RegisterNodesRequest.
+func (r *RegisterNodesRequest) DecodeFromBytes(b []byte) error {
+	offset := 0
+	r.TypeID = &datatypes.ExpandedNodeID{}
+	if err := r.TypeID.DecodeFromBytes(b[offset:]); err != nil {
+		return err
+	}
+	offset += r.TypeID.Len()
+
+	r.RequestHeader = &RequestHeader{}
+	if err := r.RequestHeader.DecodeFromBytes(b[offset:]); err != nil {
+		return err
+	}
+	offset += r.RequestHeader.Len() - len(r.RequestHeader.Payload)
+
+	r.NodesToRegister = &datatypes.NodeIDArray{}
+	return r.NodesToRegister.DecodeFromBytes(b[offset:])
+}
+
+// Serialize serializes RegisterNodesRequest into bytes.
+func (r *RegisterNodesRequest) Serialize() ([]byte, error) {
+	b := make([]byte, r.Len())
+	if err := r.SerializeTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// SerializeTo serializes RegisterNodesRequest into bytes.
+func (r *RegisterNodesRequest) SerializeTo(b []byte) error {
+	offset := 0
+	if r.TypeID != nil {
+		if err := r.TypeID.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += r.TypeID.Len()
+	}
+
+	if r.RequestHeader != nil {
+		if err := r.RequestHeader.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += r.RequestHeader.Len() - len(r.Payload)
+	}
+
+	if r.NodesToRegister != nil {
+		return r.NodesToRegister.SerializeTo(b[offset:])
+	}
+
+	return nil
+}
+
+// Len returns the actual length of RegisterNodesRequest in int.
+func (r *RegisterNodesRequest) Len() int {
+	l := 0
+	if r.TypeID != nil {
+		l += r.TypeID.Len()
+	}
+	if r.RequestHeader != nil {
+		l += (r.RequestHeader.Len() - len(r.Payload))
+	}
+	if r.NodesToRegister != nil {
+		l += r.NodesToRegister.Len()
+	}
+
+	return l
+}
+
+// ServiceType returns type of Service in uint16.
+func (r *RegisterNodesRequest) ServiceType() uint16 {
+	return ServiceTypeRegisterNodesRequest
+}
@@ -0,0 +1,108 @@
+// Copyright 2018 gopcua authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/wmnsk/gopcua/datatypes"
+)
+
+var registerNodesResponseCases = []struct {
+	description string
+	structured  *RegisterNodesResponse
+	serialized  []byte
+}{
+	{
+		"normal",
+		NewRegisterNodesResponse(
+			time.Date(2018, time.August, 10, 23, 0, 0, 0, time.UTC),
+			1, 0, nil, nil,
+			[]datatypes.NodeID{
+				datatypes.NewFourByteNodeID(2, 10),
+				datatypes.NewStringNodeID(2, "foo"),
+			},
+		),
+		[]byte{ // RegisterNodesResponse
+			// TypeID
+			0x01, 0x00, 0x33, 0x02,
+			// ResponseHeader
+			// Timestamp
+			0x00, 0x98, 0x67, 0xdd, 0xfd, 0x30, 0xd4, 0x01,
+			// RequestHandle
+			0x01, 0x00, 0x00, 0x00,
+			// ServiceResult
+			0x00, 0x00, 0x00, 0x00,
+			// ServiceDiagnostics
+			0x00,
+			// StringTable
+			0x00, 0x00, 0x00, 0x00,
+			// AdditionalHeader
+			0x00, 0x00, 0x00,
+			// RegisteredNodeIDs
+			// ArraySize
+			0x02, 0x00, 0x00, 0x00,
+			// NodeID: FourByte
+			0x01, 0x02, 0x0a, 0x00,
+			// NodeID: String
+			0x03, 0x02, 0x00, 0x03, 0x00, 0x00, 0x00, 0x66,
+			0x6f, 0x6f,
+		},
+	},
+}
+
+func TestDecodeRegisterNodesResponse(t *testing.T) {
+	for _, c := range registerNodesResponseCases {
+		got, err := DecodeRegisterNodesResponse(c.serialized)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// need to clear Payload here.
+		got.Payload = nil
+
+		if diff := cmp.Diff(got, c.structured, decodeCmpOpt); diff != "" {
+			t.Errorf("%s failed\n%s", c.description, diff)
+		}
+	}
+}
+
+func TestSerializeRegisterNodesResponse(t *testing.T) {
+	for _, c := range registerNodesResponseCases {
+		got, err := c.structured.Serialize()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if diff := cmp.Diff(got, c.serialized); diff != "" {
+			t.Errorf("%s failed\n%s", c.description, diff)
+		}
+	}
+}
+
+func TestRegisterNodesResponseLen(t *testing.T) {
+	for _, c := range registerNodesResponseCases {
+		got := c.structured.Len()
+
+		if diff := cmp.Diff(got, len(c.serialized)); diff != "" {
+			t.Errorf("%s failed\n%s", c.description, diff)
+		}
+	}
+}
+
+func TestRegisterNodesResponseServiceType(t *testing.T) {
+	for _, c := range registerNodesResponseCases {
+		if c.structured.ServiceType() != ServiceTypeRegisterNodesResponse {
+			t.Errorf(
+				"ServiceType doesn't match. Want: %d, Got: %d",
+				ServiceTypeRegisterNodesResponse,
+				c.structured.ServiceType(),
+			)
+		}
+	}
+}
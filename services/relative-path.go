@@ -0,0 +1,257 @@
+// Copyright 2018 gopcua authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package services
+
+import (
+	"encoding/binary"
+	"strconv"
+	"strings"
+
+	"github.com/wmnsk/gopcua/datatypes"
+	"github.com/wmnsk/gopcua/errors"
+	"github.com/wmnsk/gopcua/id"
+)
+
+// RelativePathElement represents a single element of RelativePath.
+//
+// Specification: Part 4, 7.26
+type RelativePathElement struct {
+	ReferenceTypeID datatypes.NodeID
+	IsInverse       *datatypes.Boolean
+	IncludeSubtypes *datatypes.Boolean
+	TargetName      *datatypes.QualifiedName
+}
+
+// NewRelativePathElement creates a new RelativePathElement.
+func NewRelativePathElement(refType datatypes.NodeID, isInverse, includeSubtypes bool, idx uint16, name string) *RelativePathElement {
+	return &RelativePathElement{
+		ReferenceTypeID: refType,
+		IsInverse:       datatypes.NewBoolean(isInverse),
+		IncludeSubtypes: datatypes.NewBoolean(includeSubtypes),
+		TargetName:      datatypes.NewQualifiedName(idx, name),
+	}
+}
+
+// DecodeRelativePathElement decodes given bytes into RelativePathElement.
+func DecodeRelativePathElement(b []byte) (*RelativePathElement, error) {
+	r := &RelativePathElement{}
+	if err := r.DecodeFromBytes(b); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// DecodeFromBytes decodes given bytes into RelativePathElement.
+func (r *RelativePathElement) DecodeFromBytes(b []byte) error {
+	refType, err := datatypes.DecodeNodeID(b)
+	if err != nil {
+		return err
+	}
+	r.ReferenceTypeID = refType
+	offset := r.ReferenceTypeID.Len()
+
+	r.IsInverse = &datatypes.Boolean{}
+	if err := r.IsInverse.DecodeFromBytes(b[offset:]); err != nil {
+		return err
+	}
+	offset += r.IsInverse.Len()
+
+	r.IncludeSubtypes = &datatypes.Boolean{}
+	if err := r.IncludeSubtypes.DecodeFromBytes(b[offset:]); err != nil {
+		return err
+	}
+	offset += r.IncludeSubtypes.Len()
+
+	r.TargetName = &datatypes.QualifiedName{}
+	return r.TargetName.DecodeFromBytes(b[offset:])
+}
+
+// Serialize serializes RelativePathElement into bytes.
+func (r *RelativePathElement) Serialize() ([]byte, error) {
+	b := make([]byte, r.Len())
+	if err := r.SerializeTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// SerializeTo serializes RelativePathElement into bytes.
+func (r *RelativePathElement) SerializeTo(b []byte) error {
+	offset := 0
+	if r.ReferenceTypeID != nil {
+		if err := r.ReferenceTypeID.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += r.ReferenceTypeID.Len()
+	}
+
+	if r.IsInverse != nil {
+		if err := r.IsInverse.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += r.IsInverse.Len()
+	}
+
+	if r.IncludeSubtypes != nil {
+		if err := r.IncludeSubtypes.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += r.IncludeSubtypes.Len()
+	}
+
+	if r.TargetName != nil {
+		return r.TargetName.SerializeTo(b[offset:])
+	}
+
+	return nil
+}
+
+// Len returns the actual length of RelativePathElement in int.
+func (r *RelativePathElement) Len() int {
+	l := 0
+	if r.ReferenceTypeID != nil {
+		l += r.ReferenceTypeID.Len()
+	}
+	if r.IsInverse != nil {
+		l += r.IsInverse.Len()
+	}
+	if r.IncludeSubtypes != nil {
+		l += r.IncludeSubtypes.Len()
+	}
+	if r.TargetName != nil {
+		l += r.TargetName.Len()
+	}
+
+	return l
+}
+
+// RelativePath defines a sequence of References and BrowseNames to follow.
+//
+// Specification: Part 4, 7.26
+type RelativePath struct {
+	ArraySize int32
+	Elements  []*RelativePathElement
+}
+
+// NewRelativePath creates a new RelativePath from multiple RelativePathElements.
+func NewRelativePath(elems []*RelativePathElement) *RelativePath {
+	if elems == nil {
+		return &RelativePath{
+			ArraySize: 0,
+		}
+	}
+
+	return &RelativePath{
+		ArraySize: int32(len(elems)),
+		Elements:  elems,
+	}
+}
+
+// ParseRelativePath parses the text format of RelativePath into RelativePath,
+// e.g. "/Objects/2:MyDevice/2:Temperature".
+//
+// Each element is followed by the HierarchicalReferences including its subtypes.
+// The namespace index is given in "<index>:<name>" form, and 0 is used if omitted.
+//
+// Specification: Part 4, A.2
+func ParseRelativePath(path string) (*RelativePath, error) {
+	var elems []*RelativePathElement
+	for _, s := range strings.Split(strings.TrimPrefix(path, "/"), "/") {
+		if s == "" {
+			return nil, errors.NewErrInvalidType(path, "parse", "empty element in path")
+		}
+
+		var idx uint64
+		name := s
+		if i := strings.Index(s, ":"); i > 0 {
+			n, err := strconv.ParseUint(s[:i], 10, 16)
+			if err == nil {
+				idx = n
+				name = s[i+1:]
+			}
+		}
+		if name == "" {
+			return nil, errors.NewErrInvalidType(path, "parse", "empty name in path")
+		}
+
+		elems = append(elems, NewRelativePathElement(
+			datatypes.NewTwoByteNodeID(id.HierarchicalReferences),
+			false, true, uint16(idx), name,
+		))
+	}
+
+	return NewRelativePath(elems), nil
+}
+
+// DecodeRelativePath decodes given bytes into RelativePath.
+func DecodeRelativePath(b []byte) (*RelativePath, error) {
+	r := &RelativePath{}
+	if err := r.DecodeFromBytes(b); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// DecodeFromBytes decodes given bytes into RelativePath.
+func (r *RelativePath) DecodeFromBytes(b []byte) error {
+	if len(b) < 4 {
+		return errors.NewErrTooShortToDecode(r, "should be longer than 4 bytes")
+	}
+
+	r.ArraySize = int32(binary.LittleEndian.Uint32(b[:4]))
+	if r.ArraySize <= 0 {
+		return nil
+	}
+
+	offset := 4
+	for i := 1; i <= int(r.ArraySize); i++ {
+		elem, err := DecodeRelativePathElement(b[offset:])
+		if err != nil {
+			return err
+		}
+		r.Elements = append(r.Elements, elem)
+		offset += elem.Len()
+	}
+
+	return nil
+}
+
+// Serialize serializes RelativePath into bytes.
+func (r *RelativePath) Serialize() ([]byte, error) {
+	b := make([]byte, r.Len())
+	if err := r.SerializeTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// SerializeTo serializes RelativePath into bytes.
+func (r *RelativePath) SerializeTo(b []byte) error {
+	offset := 4
+	binary.LittleEndian.PutUint32(b[:4], uint32(r.ArraySize))
+
+	for _, elem := range r.Elements {
+		if err := elem.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += elem.Len()
+	}
+
+	return nil
+}
+
+// Len returns the actual length of RelativePath in int.
+func (r *RelativePath) Len() int {
+	l := 4
+	for _, elem := range r.Elements {
+		l += elem.Len()
+	}
+
+	return l
+}
@@ -0,0 +1,182 @@
+// Copyright 2018 gopcua authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package services
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/wmnsk/gopcua/datatypes"
+	"github.com/wmnsk/gopcua/id"
+)
+
+var relativePathCases = []struct {
+	description string
+	structured  *RelativePath
+	serialized  []byte
+}{
+	{
+		"no-element",
+		NewRelativePath(nil),
+		[]byte{
+			// ArraySize
+			0x00, 0x00, 0x00, 0x00,
+		},
+	},
+	{
+		"single-element",
+		NewRelativePath([]*RelativePathElement{
+			NewRelativePathElement(
+				datatypes.NewTwoByteNodeID(id.HierarchicalReferences),
+				false, true, 2, "Temp",
+			),
+		}),
+		[]byte{
+			// ArraySize
+			0x01, 0x00, 0x00, 0x00,
+			// ReferenceTypeID
+			0x00, 0x21,
+			// IsInverse
+			0x00,
+			// IncludeSubtypes
+			0x01,
+			// TargetName: NamespaceIndex
+			0x02, 0x00,
+			// TargetName: Name
+			0x04, 0x00, 0x00, 0x00, 0x54, 0x65, 0x6d, 0x70,
+		},
+	},
+	{
+		"multiple-elements",
+		NewRelativePath([]*RelativePathElement{
+			NewRelativePathElement(
+				datatypes.NewTwoByteNodeID(id.HierarchicalReferences),
+				false, true, 0, "Objects",
+			),
+			NewRelativePathElement(
+				datatypes.NewFourByteNodeID(0, id.HasComponent),
+				true, false, 3, "A",
+			),
+		}),
+		[]byte{
+			// ArraySize
+			0x02, 0x00, 0x00, 0x00,
+			// ReferenceTypeID
+			0x00, 0x21,
+			// IsInverse
+			0x00,
+			// IncludeSubtypes
+			0x01,
+			// TargetName: NamespaceIndex
+			0x00, 0x00,
+			// TargetName: Name
+			0x07, 0x00, 0x00, 0x00, 0x4f, 0x62, 0x6a, 0x65,
+			0x63, 0x74, 0x73,
+			// ReferenceTypeID
+			0x01, 0x00, 0x2f, 0x00,
+			// IsInverse
+			0x01,
+			// IncludeSubtypes
+			0x00,
+			// TargetName: NamespaceIndex
+			0x03, 0x00,
+			// TargetName: Name
+			0x01, 0x00, 0x00, 0x00, 0x41,
+		},
+	},
+}
+
+func TestDecodeRelativePath(t *testing.T) {
+	for _, c := range relativePathCases {
+		got, err := DecodeRelativePath(c.serialized)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if diff := cmp.Diff(got, c.structured, decodeCmpOpt); diff != "" {
+			t.Errorf("%s failed\n%s", c.description, diff)
+		}
+	}
+}
+
+func TestSerializeRelativePath(t *testing.T) {
+	for _, c := range relativePathCases {
+		got, err := c.structured.Serialize()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if diff := cmp.Diff(got, c.serialized); diff != "" {
+			t.Errorf("%s failed\n%s", c.description, diff)
+		}
+	}
+}
+
+func TestRelativePathLen(t *testing.T) {
+	for _, c := range relativePathCases {
+		got := c.structured.Len()
+
+		if diff := cmp.Diff(got, len(c.serialized)); diff != "" {
+			t.Errorf("%s failed\n%s", c.description, diff)
+		}
+	}
+}
+
+func TestParseRelativePath(t *testing.T) {
+	hierarchical := func(idx uint16, name string) *RelativePathElement {
+		return NewRelativePathElement(
+			datatypes.NewTwoByteNodeID(id.HierarchicalReferences),
+			false, true, idx, name,
+		)
+	}
+
+	cases := []struct {
+		description string
+		path        string
+		want        *RelativePath
+	}{
+		{
+			"absolute",
+			"/Objects/2:MyDevice/2:Temperature",
+			NewRelativePath([]*RelativePathElement{
+				hierarchical(0, "Objects"),
+				hierarchical(2, "MyDevice"),
+				hierarchical(2, "Temperature"),
+			}),
+		},
+		{
+			"relative",
+			"2:MyDevice",
+			NewRelativePath([]*RelativePathElement{
+				hierarchical(2, "MyDevice"),
+			}),
+		},
+		{
+			"colon-in-name",
+			"1:a:b/x:y",
+			NewRelativePath([]*RelativePathElement{
+				hierarchical(1, "a:b"),
+				hierarchical(0, "x:y"),
+			}),
+		},
+	}
+	for _, c := range cases {
+		got, err := ParseRelativePath(c.path)
+		if err != nil {
+			t.Fatalf("%s failed: %s", c.description, err)
+		}
+
+		if diff := cmp.Diff(got, c.want); diff != "" {
+			t.Errorf("%s failed\n%s", c.description, diff)
+		}
+	}
+
+	for _, path := range []string{"", "/", "/Objects//2:MyDevice", "/Objects/2:"} {
+		if _, err := ParseRelativePath(path); err == nil {
+			t.Errorf("%q: expected error", path)
+		}
+	}
+}
@@ -0,0 +1,144 @@
+// Copyright 2018 gopcua authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package services
+
+import (
+	"encoding/binary"
+	"time"
+
+	"github.com/wmnsk/gopcua/datatypes"
+)
+
+// RepublishRequest requests the Subscription to republish a NotificationMessage
+// from its retransmission queue.
+//
+// Specification: Part 4, 5.13.6.2
+type RepublishRequest struct {
+	TypeID *datatypes.ExpandedNodeID
+	*RequestHeader
+	SubscriptionID uint32
+
+	// The sequence number of a specific NotificationMessage to be republished.
+	RetransmitSequenceNumber uint32
+}
+
+// NewRepublishRequest creates a new RepublishRequest.
+func NewRepublishRequest(ts time.Time, authToken datatypes.NodeID, handle, diag, timeout uint32, auditID string, subID uint32, seq uint32) *RepublishRequest {
+	return &RepublishRequest{
+		TypeID: datatypes.NewExpandedNodeID(
+			false, false,
+			datatypes.NewFourByteNodeID(
+				0, ServiceTypeRepublishRequest,
+			),
+			"", 0,
+		),
+		RequestHeader: NewRequestHeader(
+			authToken,
+			ts,
+			handle,
+			diag,
+			timeout,
+			auditID,
+			NewAdditionalHeader(
+				datatypes.NewExpandedNodeID(
+					false, false,
+					datatypes.NewTwoByteNodeID(0),
+					"", 0,
+				),
+				0x00,
+			),
+			nil,
+		),
+		SubscriptionID:           subID,
+		RetransmitSequenceNumber: seq,
+	}
+}
+
+// DecodeRepublishRequest decodes given bytes into RepublishRequest.
+func DecodeRepublishRequest(b []byte) (*RepublishRequest, error) {
+	r := &RepublishRequest{}
+	if err := r.DecodeFromBytes(b); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// DecodeFromBytes decodes given bytes into RepublishRequest.
+func (r *RepublishRequest) DecodeFromBytes(b []byte) error {
+	offset := 0
+	r.TypeID = &datatypes.ExpandedNodeID{}
+	if err := r.TypeID.DecodeFromBytes(b[offset:]); err != nil {
+		return err
+	}
+	offset += r.TypeID.Len()
+
+	r.RequestHeader = &RequestHeader{}
+	if err := r.RequestHeader.DecodeFromBytes(b[offset:]); err != nil {
+		return err
+	}
+	offset += r.RequestHeader.Len() - len(r.RequestHeader.Payload)
+
+	r.SubscriptionID = binary.LittleEndian.Uint32(b[offset : offset+4])
+	offset += 4
+
+	r.RetransmitSequenceNumber = binary.LittleEndian.Uint32(b[offset : offset+4])
+
+	return nil
+}
+
+// Serialize serializes RepublishRequest into bytes.
+func (r *RepublishRequest) Serialize() ([]byte, error) {
+	b := make([]byte, r.Len())
+	if err := r.SerializeTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// SerializeTo serializes RepublishRequest into bytes.
+func (r *RepublishRequest) SerializeTo(b []byte) error {
+	offset := 0
+	if r.TypeID != nil {
+		if err := r.TypeID.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += r.TypeID.Len()
+	}
+
+	if r.RequestHeader != nil {
+		if err := r.RequestHeader.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += r.RequestHeader.Len() - len(r.Payload)
+	}
+
+	binary.LittleEndian.PutUint32(b[offset:offset+4], r.SubscriptionID)
+	offset += 4
+
+	binary.LittleEndian.PutUint32(b[offset:offset+4], r.RetransmitSequenceNumber)
+
+	return nil
+}
+
+// Len returns the actual length of RepublishRequest in int.
+func (r *RepublishRequest) Len() int {
+	// SubscriptionID + RetransmitSequenceNumber
+	l := 8
+	if r.TypeID != nil {
+		l += r.TypeID.Len()
+	}
+	if r.RequestHeader != nil {
+		l += (r.RequestHeader.Len() - len(r.Payload))
+	}
+
+	return l
+}
+
+// ServiceType returns type of Service in uint16.
+func (r *RepublishRequest) ServiceType() uint16 {
+	return ServiceTypeRepublishRequest
+}
@@ -0,0 +1,135 @@
+// Copyright 2018 gopcua authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package services
+
+import (
+	"time"
+
+	"github.com/wmnsk/gopcua/datatypes"
+)
+
+// RepublishResponse represents the response to a RepublishRequest.
+// If the NotificationMessage is no longer available, ServiceResult is BadMessageNotAvailable.
+//
+// Specification: Part 4, 5.13.6.2
+type RepublishResponse struct {
+	TypeID *datatypes.ExpandedNodeID
+	*ResponseHeader
+	NotificationMessage *NotificationMessage
+}
+
+// NewRepublishResponse creates a new RepublishResponse.
+func NewRepublishResponse(ts time.Time, handle, code uint32, diag *DiagnosticInfo, strs []string, msg *NotificationMessage) *RepublishResponse {
+	return &RepublishResponse{
+		TypeID: datatypes.NewExpandedNodeID(
+			false, false,
+			datatypes.NewFourByteNodeID(
+				0, ServiceTypeRepublishResponse,
+			),
+			"", 0,
+		),
+		ResponseHeader: NewResponseHeader(
+			ts,
+			handle,
+			code,
+			diag,
+			strs,
+			NewAdditionalHeader(
+				datatypes.NewExpandedNodeID(
+					false, false,
+					datatypes.NewTwoByteNodeID(0),
+					"", 0,
+				),
+				0x00,
+			),
+			nil,
+		),
+		NotificationMessage: msg,
+	}
+}
+
+// DecodeRepublishResponse decodes given bytes into RepublishResponse.
+func DecodeRepublishResponse(b []byte) (*RepublishResponse, error) {
+	r := &RepublishResponse{}
+	if err := r.DecodeFromBytes(b); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// DecodeFromBytes decodes given bytes into RepublishResponse.
+func (r *RepublishResponse) DecodeFromBytes(b []byte) error {
+	offset := 0
+	r.TypeID = &datatypes.ExpandedNodeID{}
+	if err := r.TypeID.DecodeFromBytes(b[offset:]); err != nil {
+		return err
+	}
+	offset += r.TypeID.Len()
+
+	r.ResponseHeader = &ResponseHeader{}
+	if err := r.ResponseHeader.DecodeFromBytes(b[offset:]); err != nil {
+		return err
+	}
+	offset += r.ResponseHeader.Len() - len(r.ResponseHeader.Payload)
+
+	r.NotificationMessage = &NotificationMessage{}
+	return r.NotificationMessage.DecodeFromBytes(b[offset:])
+}
+
+// Serialize serializes RepublishResponse into bytes.
+func (r *RepublishResponse) Serialize() ([]byte, error) {
+	b := make([]byte, r.Len())
+	if err := r.SerializeTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// SerializeTo serializes RepublishResponse into bytes.
+func (r *RepublishResponse) SerializeTo(b []byte) error {
+	offset := 0
+	if r.TypeID != nil {
+		if err := r.TypeID.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += r.TypeID.Len()
+	}
+
+	if r.ResponseHeader != nil {
+		if err := r.ResponseHeader.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += r.ResponseHeader.Len() - len(r.Payload)
+	}
+
+	if r.NotificationMessage != nil {
+		return r.NotificationMessage.SerializeTo(b[offset:])
+	}
+
+	return nil
+}
+
+// Len returns the actual length of RepublishResponse in int.
+func (r *RepublishResponse) Len() int {
+	l := 0
+	if r.TypeID != nil {
+		l += r.TypeID.Len()
+	}
+	if r.ResponseHeader != nil {
+		l += (r.ResponseHeader.Len() - len(r.Payload))
+	}
+	if r.NotificationMessage != nil {
+		l += r.NotificationMessage.Len()
+	}
+
+	return l
+}
+
+// ServiceType returns type of Service in uint16.
+func (r *RepublishResponse) ServiceType() uint16 {
+	return ServiceTypeRepublishResponse
+}
@@ -0,0 +1,124 @@
+// Copyright 2018 gopcua authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/wmnsk/gopcua/datatypes"
+	"github.com/wmnsk/gopcua/id"
+)
+
+var republishResponseCases = []struct {
+	description string
+	structured  *RepublishResponse
+	serialized  []byte
+}{
+	{
+		"normal",
+		NewRepublishResponse(
+			time.Date(2018, time.August, 10, 23, 0, 0, 0, time.UTC),
+			1, 0, nil, nil,
+			NewNotificationMessage(
+				2, time.Date(2018, time.August, 10, 23, 0, 0, 0, time.UTC),
+				datatypes.NewExtensionObject(
+					datatypes.NewExpandedNodeID(
+						false, false,
+						datatypes.NewFourByteNodeID(0, id.StatusChangeNotification_Encoding_DefaultBinary),
+						"", 0,
+					),
+					0x01, []byte{0xde, 0xad},
+				),
+			),
+		),
+		[]byte{ // RepublishResponse
+			// TypeID
+			0x01, 0x00, 0x43, 0x03,
+			// ResponseHeader
+			// Timestamp
+			0x00, 0x98, 0x67, 0xdd, 0xfd, 0x30, 0xd4, 0x01,
+			// RequestHandle
+			0x01, 0x00, 0x00, 0x00,
+			// ServiceResult
+			0x00, 0x00, 0x00, 0x00,
+			// ServiceDiagnostics
+			0x00,
+			// StringTable
+			0x00, 0x00, 0x00, 0x00,
+			// AdditionalHeader
+			0x00, 0x00, 0x00,
+			// NotificationMessage
+			// SequenceNumber
+			0x02, 0x00, 0x00, 0x00,
+			// PublishTime
+			0x00, 0x98, 0x67, 0xdd, 0xfd, 0x30, 0xd4, 0x01,
+			// NotificationData
+			// ArraySize
+			0x01, 0x00, 0x00, 0x00,
+			// TypeID
+			0x01, 0x00, 0x34, 0x03,
+			// EncodingMask
+			0x01,
+			// Length
+			0x06, 0x00, 0x00, 0x00,
+			// Body
+			0x02, 0x00, 0x00, 0x00, 0xde, 0xad,
+		},
+	},
+}
+
+func TestDecodeRepublishResponse(t *testing.T) {
+	for _, c := range republishResponseCases {
+		got, err := DecodeRepublishResponse(c.serialized)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// need to clear Payload here.
+		got.Payload = nil
+
+		if diff := cmp.Diff(got, c.structured, decodeCmpOpt); diff != "" {
+			t.Errorf("%s failed\n%s", c.description, diff)
+		}
+	}
+}
+
+func TestSerializeRepublishResponse(t *testing.T) {
+	for _, c := range republishResponseCases {
+		got, err := c.structured.Serialize()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if diff := cmp.Diff(got, c.serialized); diff != "" {
+			t.Errorf("%s failed\n%s", c.description, diff)
+		}
+	}
+}
+
+func TestRepublishResponseLen(t *testing.T) {
+	for _, c := range republishResponseCases {
+		got := c.structured.Len()
+
+		if diff := cmp.Diff(got, len(c.serialized)); diff != "" {
+			t.Errorf("%s failed\n%s", c.description, diff)
+		}
+	}
+}
+
+func TestRepublishResponseServiceType(t *testing.T) {
+	for _, c := range republishResponseCases {
+		if c.structured.ServiceType() != ServiceTypeRepublishResponse {
+			t.Errorf(
+				"ServiceType doesn't match. Want: %d, Got: %d",
+				ServiceTypeRepublishResponse,
+				c.structured.ServiceType(),
+			)
+		}
+	}
+}
@@ -145,3 +145,28 @@ func (r *ResponseHeader) String() string {
 		r.Payload,
 	)
 }
+
+// Diagnostics returns ServiceDiagnostics in human-readable text, resolved against
+// the StringTable. It returns empty string if the Server did not return any, which
+// is the case unless they are requested with ReturnDiagnostics in RequestHeader.
+//
+// The DiagnosticInfos of each operation in the response share the same StringTable,
+// so they can be resolved with DiagnosticInfo.Resolve(r.StringTable).
+func (r *ResponseHeader) Diagnostics() string {
+	if r.ServiceDiagnostics == nil || r.ServiceDiagnostics.EncodingMask == 0 {
+		return ""
+	}
+
+	return r.ServiceDiagnostics.Resolve(r.StringTable)
+}
+
+// ClockOffset estimates the offset of the Server's clock from the local one, from
+// the local time the request was sent and the local time the response was received.
+// It is positive if the Server's clock is ahead.
+//
+// The Timestamp of ResponseHeader is assumed to be set midway between sent and
+// received, as the Server's processing time cannot be told apart from the latency.
+// The round-trip time is simply received.Sub(sent).
+func (r *ResponseHeader) ClockOffset(sent, received time.Time) time.Duration {
+	return r.Timestamp.Sub(sent.Add(received.Sub(sent) / 2))
+}
@@ -95,3 +95,24 @@ func TestSerializeResponseHeader(t *testing.T) {
 	}
 	t.Logf("%x", serialized)
 }
+
+func TestResponseHeaderClockOffset(t *testing.T) {
+	sent := time.Date(2018, time.August, 10, 23, 0, 0, 0, time.UTC)
+	received := sent.Add(200 * time.Millisecond)
+
+	cases := []struct {
+		timestamp time.Time
+		want      time.Duration
+	}{
+		{sent.Add(100 * time.Millisecond), 0},
+		{sent.Add(1100 * time.Millisecond), time.Second},
+		{sent.Add(-900 * time.Millisecond), -time.Second},
+	}
+
+	for i, c := range cases {
+		r := NewResponseHeader(c.timestamp, 1, 0, nil, nil, nil, nil)
+		if got := r.ClockOffset(sent, received); got != c.want {
+			t.Errorf("case #%d: ClockOffset doesn't match. Want: %s, Got: %s", i, c.want, got)
+		}
+	}
+}
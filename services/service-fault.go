@@ -0,0 +1,133 @@
+// Copyright 2018 gopcua authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package services
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/wmnsk/gopcua/datatypes"
+)
+
+// ServiceFault is returned by the Server instead of the response of any Service
+// when the request is rejected at the Service level, e.g. with BadSessionIdInvalid.
+// The reason is in the ServiceResult and ServiceDiagnostics of ResponseHeader.
+//
+// ServiceFault implements error, so that it can be returned as it is.
+//
+// Specification: Part 4, 7.30
+type ServiceFault struct {
+	TypeID *datatypes.ExpandedNodeID
+	*ResponseHeader
+}
+
+// NewServiceFault creates a new ServiceFault.
+func NewServiceFault(ts time.Time, handle, code uint32, diag *DiagnosticInfo, strs []string) *ServiceFault {
+	return &ServiceFault{
+		TypeID: datatypes.NewExpandedNodeID(
+			false, false,
+			datatypes.NewFourByteNodeID(
+				0, ServiceTypeServiceFault,
+			),
+			"", 0,
+		),
+		ResponseHeader: NewResponseHeader(
+			ts,
+			handle,
+			code,
+			diag,
+			strs,
+			NewAdditionalHeader(
+				datatypes.NewExpandedNodeID(
+					false, false,
+					datatypes.NewTwoByteNodeID(0),
+					"", 0,
+				),
+				0x00,
+			),
+			nil,
+		),
+	}
+}
+
+// DecodeServiceFault decodes given bytes into ServiceFault.
+func DecodeServiceFault(b []byte) (*ServiceFault, error) {
+	s := &ServiceFault{}
+	if err := s.DecodeFromBytes(b); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// DecodeFromBytes decodes given bytes into ServiceFault.
+func (s *ServiceFault) DecodeFromBytes(b []byte) error {
+	s.TypeID = &datatypes.ExpandedNodeID{}
+	if err := s.TypeID.DecodeFromBytes(b); err != nil {
+		return err
+	}
+
+	s.ResponseHeader = &ResponseHeader{}
+	return s.ResponseHeader.DecodeFromBytes(b[s.TypeID.Len():])
+}
+
+// Serialize serializes ServiceFault into bytes.
+func (s *ServiceFault) Serialize() ([]byte, error) {
+	b := make([]byte, s.Len())
+	if err := s.SerializeTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// SerializeTo serializes ServiceFault into bytes.
+func (s *ServiceFault) SerializeTo(b []byte) error {
+	offset := 0
+	if s.TypeID != nil {
+		if err := s.TypeID.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += s.TypeID.Len()
+	}
+
+	if s.ResponseHeader != nil {
+		return s.ResponseHeader.SerializeTo(b[offset:])
+	}
+
+	return nil
+}
+
+// Len returns the actual length of ServiceFault in int.
+func (s *ServiceFault) Len() int {
+	l := 0
+	if s.TypeID != nil {
+		l += s.TypeID.Len()
+	}
+	if s.ResponseHeader != nil {
+		l += (s.ResponseHeader.Len() - len(s.Payload))
+	}
+
+	return l
+}
+
+// ServiceType returns type of Service in uint16.
+func (s *ServiceFault) ServiceType() uint16 {
+	return ServiceTypeServiceFault
+}
+
+// Error returns the ServiceResult and ServiceDiagnostics of ServiceFault in string.
+// ServiceDiagnostics is resolved against the StringTable, see ResponseHeader.Diagnostics.
+func (s *ServiceFault) Error() string {
+	if s.ResponseHeader == nil {
+		return "service fault"
+	}
+
+	msg := fmt.Sprintf("service fault: 0x%08x", s.ServiceResult)
+	if d := s.Diagnostics(); d != "" {
+		msg += fmt.Sprintf(" (%s)", d)
+	}
+	return msg
+}
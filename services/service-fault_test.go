@@ -0,0 +1,114 @@
+// Copyright 2018 gopcua authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+var serviceFaultCases = []struct {
+	description string
+	structured  *ServiceFault
+	serialized  []byte
+}{
+	{
+		"normal",
+		NewServiceFault(
+			time.Date(2018, time.August, 10, 23, 0, 0, 0, time.UTC),
+			1, 0x80250000, nil, nil,
+		),
+		[]byte{ // ServiceFault
+			// TypeID
+			0x01, 0x00, 0x8d, 0x01,
+			// ResponseHeader
+			// Timestamp
+			0x00, 0x98, 0x67, 0xdd, 0xfd, 0x30, 0xd4, 0x01,
+			// RequestHandle
+			0x01, 0x00, 0x00, 0x00,
+			// ServiceResult: BadSessionIdInvalid
+			0x00, 0x00, 0x25, 0x80,
+			// ServiceDiagnostics
+			0x00,
+			// StringTable
+			0x00, 0x00, 0x00, 0x00,
+			// AdditionalHeader
+			0x00, 0x00, 0x00,
+		},
+	},
+}
+
+func TestDecodeServiceFault(t *testing.T) {
+	for _, c := range serviceFaultCases {
+		got, err := DecodeServiceFault(c.serialized)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// need to clear Payload here.
+		got.Payload = nil
+
+		if diff := cmp.Diff(got, c.structured, decodeCmpOpt); diff != "" {
+			t.Errorf("%s failed\n%s", c.description, diff)
+		}
+	}
+}
+
+func TestSerializeServiceFault(t *testing.T) {
+	for _, c := range serviceFaultCases {
+		got, err := c.structured.Serialize()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if diff := cmp.Diff(got, c.serialized); diff != "" {
+			t.Errorf("%s failed\n%s", c.description, diff)
+		}
+	}
+}
+
+func TestServiceFaultLen(t *testing.T) {
+	for _, c := range serviceFaultCases {
+		got := c.structured.Len()
+
+		if diff := cmp.Diff(got, len(c.serialized)); diff != "" {
+			t.Errorf("%s failed\n%s", c.description, diff)
+		}
+	}
+}
+
+func TestServiceFaultServiceType(t *testing.T) {
+	for _, c := range serviceFaultCases {
+		if c.structured.ServiceType() != ServiceTypeServiceFault {
+			t.Errorf(
+				"ServiceType doesn't match. Want: %d, Got: %d",
+				ServiceTypeServiceFault,
+				c.structured.ServiceType(),
+			)
+		}
+	}
+}
+
+func TestServiceFaultError(t *testing.T) {
+	var err error = serviceFaultCases[0].structured
+	if got, want := err.Error(), "service fault: 0x80250000"; got != want {
+		t.Errorf("Error doesn't match. Want: %s, Got: %s", want, got)
+	}
+
+	err = NewServiceFault(
+		time.Date(2018, time.August, 10, 23, 0, 0, 0, time.UTC),
+		1, 0x80250000,
+		NewDiagnosticInfo(
+			true, false, true, false, false, false, false,
+			0, 0, 0, 1, nil, 0, nil,
+		),
+		[]string{"BadSessionIdInvalid", "The session id is not valid."},
+	)
+	if got, want := err.Error(), "service fault: 0x80250000 (symbolic id: BadSessionIdInvalid, text: The session id is not valid.)"; got != want {
+		t.Errorf("Error doesn't match. Want: %s, Got: %s", want, got)
+	}
+}
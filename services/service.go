@@ -11,20 +11,59 @@ import (
 
 // ServiceType definitions.
 const (
-	ServiceTypeGetEndpointsRequest        uint16 = 428
-	ServiceTypeGetEndpointsResponse              = 431
-	ServiceTypeOpenSecureChannelRequest          = 446
-	ServiceTypeOpenSecureChannelResponse         = 449
-	ServiceTypeCloseSecureChannelRequest         = 452
-	ServiceTypeCloseSecureChannelResponse        = 455
-	ServiceTypeCreateSessionRequest              = 461
-	ServiceTypeCreateSessionResponse             = 464
-	ServiceTypeActivateSessionRequest            = 467
-	ServiceTypeActivateSessionResponse           = 470
-	ServiceTypeCloseSessionRequest               = 473
-	ServiceTypeCloseSessionResponse              = 476
-	ServiceTypeReadRequest                       = 631
-	ServiceTypeReadResponse                      = 634
+	ServiceTypeServiceFault                          uint16 = 397
+	ServiceTypeGetEndpointsRequest                          = 428
+	ServiceTypeGetEndpointsResponse                         = 431
+	ServiceTypeOpenSecureChannelRequest                     = 446
+	ServiceTypeOpenSecureChannelResponse                    = 449
+	ServiceTypeCloseSecureChannelRequest                    = 452
+	ServiceTypeCloseSecureChannelResponse                   = 455
+	ServiceTypeCreateSessionRequest                         = 461
+	ServiceTypeCreateSessionResponse                        = 464
+	ServiceTypeActivateSessionRequest                       = 467
+	ServiceTypeActivateSessionResponse                      = 470
+	ServiceTypeCloseSessionRequest                          = 473
+	ServiceTypeCloseSessionResponse                         = 476
+	ServiceTypeCancelRequest                                = 479
+	ServiceTypeCancelResponse                               = 482
+	ServiceTypeAddNodesRequest                              = 488
+	ServiceTypeAddNodesResponse                             = 491
+	ServiceTypeAddReferencesRequest                         = 494
+	ServiceTypeAddReferencesResponse                        = 497
+	ServiceTypeDeleteNodesRequest                           = 500
+	ServiceTypeDeleteNodesResponse                          = 503
+	ServiceTypeDeleteReferencesRequest                      = 506
+	ServiceTypeDeleteReferencesResponse                     = 509
+	ServiceTypeBrowseRequest                                = 527
+	ServiceTypeBrowseResponse                               = 530
+	ServiceTypeBrowseNextRequest                            = 533
+	ServiceTypeBrowseNextResponse                           = 536
+	ServiceTypeTranslateBrowsePathsToNodeIDsRequest         = 554
+	ServiceTypeTranslateBrowsePathsToNodeIDsResponse        = 557
+	ServiceTypeRegisterNodesRequest                         = 560
+	ServiceTypeRegisterNodesResponse                        = 563
+	ServiceTypeUnregisterNodesRequest                       = 566
+	ServiceTypeUnregisterNodesResponse                      = 569
+	ServiceTypeReadRequest                                  = 631
+	ServiceTypeReadResponse                                 = 634
+	ServiceTypeHistoryReadRequest                           = 664
+	ServiceTypeHistoryReadResponse                          = 667
+	ServiceTypeWriteRequest                                 = 673
+	ServiceTypeWriteResponse                                = 676
+	ServiceTypeCreateMonitoredItemsRequest                  = 751
+	ServiceTypeCreateMonitoredItemsResponse                 = 754
+	ServiceTypeModifyMonitoredItemsRequest                  = 763
+	ServiceTypeModifyMonitoredItemsResponse                 = 766
+	ServiceTypeSetMonitoringModeRequest                     = 769
+	ServiceTypeSetMonitoringModeResponse                    = 772
+	ServiceTypeModifySubscriptionRequest                    = 793
+	ServiceTypeModifySubscriptionResponse                   = 796
+	ServiceTypeSetPublishingModeRequest                     = 799
+	ServiceTypeSetPublishingModeResponse                    = 802
+	ServiceTypeRepublishRequest                             = 832
+	ServiceTypeRepublishResponse                            = 835
+	ServiceTypeDeleteSubscriptionsRequest                   = 847
+	ServiceTypeDeleteSubscriptionsResponse                  = 850
 )
 
 // Service is an interface to handle any kind of OPC UA Services.
@@ -51,6 +90,8 @@ func Decode(b []byte) (Service, error) {
 	}
 
 	switch n.Identifier {
+	case ServiceTypeServiceFault:
+		s = &ServiceFault{}
 	case ServiceTypeOpenSecureChannelRequest:
 		s = &OpenSecureChannelRequest{}
 	case ServiceTypeOpenSecureChannelResponse:
@@ -71,14 +112,90 @@ func Decode(b []byte) (Service, error) {
 		s = &CloseSessionRequest{}
 	case ServiceTypeCloseSessionResponse:
 		s = &CloseSessionResponse{}
+	case ServiceTypeCancelRequest:
+		s = &CancelRequest{}
+	case ServiceTypeCancelResponse:
+		s = &CancelResponse{}
+	case ServiceTypeAddNodesRequest:
+		s = &AddNodesRequest{}
+	case ServiceTypeAddNodesResponse:
+		s = &AddNodesResponse{}
+	case ServiceTypeAddReferencesRequest:
+		s = &AddReferencesRequest{}
+	case ServiceTypeAddReferencesResponse:
+		s = &AddReferencesResponse{}
+	case ServiceTypeDeleteNodesRequest:
+		s = &DeleteNodesRequest{}
+	case ServiceTypeDeleteNodesResponse:
+		s = &DeleteNodesResponse{}
+	case ServiceTypeDeleteReferencesRequest:
+		s = &DeleteReferencesRequest{}
+	case ServiceTypeDeleteReferencesResponse:
+		s = &DeleteReferencesResponse{}
+	case ServiceTypeBrowseRequest:
+		s = &BrowseRequest{}
+	case ServiceTypeBrowseResponse:
+		s = &BrowseResponse{}
+	case ServiceTypeBrowseNextRequest:
+		s = &BrowseNextRequest{}
+	case ServiceTypeBrowseNextResponse:
+		s = &BrowseNextResponse{}
 	case ServiceTypeActivateSessionRequest:
 		s = &ActivateSessionRequest{}
 	case ServiceTypeActivateSessionResponse:
 		s = &ActivateSessionResponse{}
+	case ServiceTypeTranslateBrowsePathsToNodeIDsRequest:
+		s = &TranslateBrowsePathsToNodeIDsRequest{}
+	case ServiceTypeTranslateBrowsePathsToNodeIDsResponse:
+		s = &TranslateBrowsePathsToNodeIDsResponse{}
+	case ServiceTypeRegisterNodesRequest:
+		s = &RegisterNodesRequest{}
+	case ServiceTypeRegisterNodesResponse:
+		s = &RegisterNodesResponse{}
+	case ServiceTypeUnregisterNodesRequest:
+		s = &UnregisterNodesRequest{}
+	case ServiceTypeUnregisterNodesResponse:
+		s = &UnregisterNodesResponse{}
 	case ServiceTypeReadRequest:
 		s = &ReadRequest{}
 	case ServiceTypeReadResponse:
 		s = &ReadResponse{}
+	case ServiceTypeHistoryReadRequest:
+		s = &HistoryReadRequest{}
+	case ServiceTypeHistoryReadResponse:
+		s = &HistoryReadResponse{}
+	case ServiceTypeWriteRequest:
+		s = &WriteRequest{}
+	case ServiceTypeWriteResponse:
+		s = &WriteResponse{}
+	case ServiceTypeCreateMonitoredItemsRequest:
+		s = &CreateMonitoredItemsRequest{}
+	case ServiceTypeCreateMonitoredItemsResponse:
+		s = &CreateMonitoredItemsResponse{}
+	case ServiceTypeModifyMonitoredItemsRequest:
+		s = &ModifyMonitoredItemsRequest{}
+	case ServiceTypeModifyMonitoredItemsResponse:
+		s = &ModifyMonitoredItemsResponse{}
+	case ServiceTypeSetMonitoringModeRequest:
+		s = &SetMonitoringModeRequest{}
+	case ServiceTypeSetMonitoringModeResponse:
+		s = &SetMonitoringModeResponse{}
+	case ServiceTypeModifySubscriptionRequest:
+		s = &ModifySubscriptionRequest{}
+	case ServiceTypeModifySubscriptionResponse:
+		s = &ModifySubscriptionResponse{}
+	case ServiceTypeSetPublishingModeRequest:
+		s = &SetPublishingModeRequest{}
+	case ServiceTypeSetPublishingModeResponse:
+		s = &SetPublishingModeResponse{}
+	case ServiceTypeRepublishRequest:
+		s = &RepublishRequest{}
+	case ServiceTypeRepublishResponse:
+		s = &RepublishResponse{}
+	case ServiceTypeDeleteSubscriptionsRequest:
+		s = &DeleteSubscriptionsRequest{}
+	case ServiceTypeDeleteSubscriptionsResponse:
+		s = &DeleteSubscriptionsResponse{}
 	default:
 		return nil, errors.NewErrUnsupported(n.Identifier, "unsupported or not implemented yet.")
 	}
@@ -230,7 +230,7 @@ var testServiceBytes = [][]byte{
 		// ClientCertificate
 		0xff, 0xff, 0xff, 0xff,
 		// RequestedTimeout
-		0x80, 0x8d, 0x5b, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x60, 0xe3, 0x56, 0x41,
 		// MaxResponseMessageSize
 		0xfe, 0xff, 0x00, 0x00,
 	},
@@ -257,7 +257,7 @@ var testServiceBytes = [][]byte{
 		0x22, 0x87, 0x62, 0xba, 0x81, 0xe1, 0x11, 0xa6,
 		0x43, 0xf8, 0x77, 0x7b, 0xc6, 0x2f, 0xc8,
 		// RevisedSessionTimeout
-		0x80, 0x8d, 0x5b, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x60, 0xe3, 0x56, 0x41,
 		// ServerNonce
 		0xff, 0xff, 0xff, 0xff,
 		// ServerCertificate
@@ -544,7 +544,7 @@ func TestDecode(t *testing.T) {
 		case cs.ClientCertificate.Get() != nil:
 			t.Errorf("ClientCertificate doesn't match. Want: %v, Got: %v", nil, cs.ClientCertificate.Get())
 		case cs.RequestedSessionTimeout != 6000000:
-			t.Errorf("RequestedSessionTimeout doesn't match. Want: %d, Got: %d", 6000000, cs.RequestedSessionTimeout)
+			t.Errorf("RequestedSessionTimeout doesn't match. Want: %v, Got: %v", 6000000, cs.RequestedSessionTimeout)
 		case cs.MaxResponseMessageSize != 65534:
 			t.Errorf("MaxResponseMessageSize doesn't match. Want: %d, Got: %d", 65534, cs.MaxResponseMessageSize)
 		}
@@ -581,7 +581,7 @@ func TestDecode(t *testing.T) {
 		// case authenticationToken.Identifier != 1:
 		// 	t.Errorf("AuthenticationToken doesn't match. Want: %d, Got: %d", 1, authenticationToken.Identifier)
 		case cs.RevisedSessionTimeout != 6000000:
-			t.Errorf("RevisedSessionTimeout doesn't match. Want: %d, Got: %d", 6000000, cs.RevisedSessionTimeout)
+			t.Errorf("RevisedSessionTimeout doesn't match. Want: %v, Got: %v", 6000000, cs.RevisedSessionTimeout)
 		case cs.ServerNonce.Get() != nil:
 			t.Errorf("ServerNonce doesn't match. Want: %v, Got: %v", nil, cs.ServerNonce.Get())
 		case cs.ServerCertificate.Get() != nil:
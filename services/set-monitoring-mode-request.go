@@ -0,0 +1,153 @@
+// Copyright 2018 gopcua authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package services
+
+import (
+	"encoding/binary"
+	"time"
+
+	"github.com/wmnsk/gopcua/datatypes"
+)
+
+// SetMonitoringModeRequest is used to set the monitoring mode for one or more MonitoredItems of a Subscription.
+//
+// Specification: Part 4, 5.12.4.2
+type SetMonitoringModeRequest struct {
+	TypeID *datatypes.ExpandedNodeID
+	*RequestHeader
+	SubscriptionID   uint32
+	MonitoringMode   MonitoringMode
+	MonitoredItemIDs *datatypes.Uint32Array
+}
+
+// NewSetMonitoringModeRequest creates a new SetMonitoringModeRequest.
+func NewSetMonitoringModeRequest(ts time.Time, authToken datatypes.NodeID, handle, diag, timeout uint32, auditID string, subID uint32, mode MonitoringMode, ids ...uint32) *SetMonitoringModeRequest {
+	return &SetMonitoringModeRequest{
+		TypeID: datatypes.NewExpandedNodeID(
+			false, false,
+			datatypes.NewFourByteNodeID(
+				0, ServiceTypeSetMonitoringModeRequest,
+			),
+			"", 0,
+		),
+		RequestHeader: NewRequestHeader(
+			authToken,
+			ts,
+			handle,
+			diag,
+			timeout,
+			auditID,
+			NewAdditionalHeader(
+				datatypes.NewExpandedNodeID(
+					false, false,
+					datatypes.NewTwoByteNodeID(0),
+					"", 0,
+				),
+				0x00,
+			),
+			nil,
+		),
+		SubscriptionID:   subID,
+		MonitoringMode:   mode,
+		MonitoredItemIDs: datatypes.NewUint32Array(ids),
+	}
+}
+
+// DecodeSetMonitoringModeRequest decodes given bytes into SetMonitoringModeRequest.
+func DecodeSetMonitoringModeRequest(b []byte) (*SetMonitoringModeRequest, error) {
+	s := &SetMonitoringModeRequest{}
+	if err := s.DecodeFromBytes(b); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// DecodeFromBytes decodes given bytes into SetMonitoringModeRequest.
+func (s *SetMonitoringModeRequest) DecodeFromBytes(b []byte) error {
+	offset := 0
+	s.TypeID = &datatypes.ExpandedNodeID{}
+	if err := s.TypeID.DecodeFromBytes(b[offset:]); err != nil {
+		return err
+	}
+	offset += s.TypeID.Len()
+
+	s.RequestHeader = &RequestHeader{}
+	if err := s.RequestHeader.DecodeFromBytes(b[offset:]); err != nil {
+		return err
+	}
+	offset += s.RequestHeader.Len() - len(s.RequestHeader.Payload)
+
+	s.SubscriptionID = binary.LittleEndian.Uint32(b[offset : offset+4])
+	offset += 4
+
+	s.MonitoringMode = MonitoringMode(binary.LittleEndian.Uint32(b[offset : offset+4]))
+	offset += 4
+
+	s.MonitoredItemIDs = &datatypes.Uint32Array{}
+	return s.MonitoredItemIDs.DecodeFromBytes(b[offset:])
+}
+
+// Serialize serializes SetMonitoringModeRequest into bytes.
+func (s *SetMonitoringModeRequest) Serialize() ([]byte, error) {
+	b := make([]byte, s.Len())
+	if err := s.SerializeTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// SerializeTo serializes SetMonitoringModeRequest into bytes.
+func (s *SetMonitoringModeRequest) SerializeTo(b []byte) error {
+	offset := 0
+	if s.TypeID != nil {
+		if err := s.TypeID.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += s.TypeID.Len()
+	}
+
+	if s.RequestHeader != nil {
+		if err := s.RequestHeader.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += s.RequestHeader.Len() - len(s.Payload)
+	}
+
+	binary.LittleEndian.PutUint32(b[offset:offset+4], s.SubscriptionID)
+	offset += 4
+
+	binary.LittleEndian.PutUint32(b[offset:offset+4], uint32(s.MonitoringMode))
+	offset += 4
+
+	if s.MonitoredItemIDs != nil {
+		return s.MonitoredItemIDs.SerializeTo(b[offset:])
+	}
+
+	return nil
+}
+
+// Len returns the actual length of SetMonitoringModeRequest in int.
+func (s *SetMonitoringModeRequest) Len() int {
+	// SubscriptionID + MonitoringMode
+	l := 8
+	if s.TypeID != nil {
+		l += s.TypeID.Len()
+	}
+	if s.RequestHeader != nil {
+		l += (s.RequestHeader.Len() - len(s.Payload))
+	}
+	if s.MonitoredItemIDs != nil {
+		l += s.MonitoredItemIDs.Len()
+	}
+
+	return l
+}
+
+// ServiceType returns type of Service in uint16.
+func (s *SetMonitoringModeRequest) ServiceType() uint16 {
+	return ServiceTypeSetMonitoringModeRequest
+}
@@ -0,0 +1,153 @@
+// Copyright 2018 gopcua authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package services
+
+import (
+	"time"
+
+	"github.com/wmnsk/gopcua/datatypes"
+)
+
+// SetMonitoringModeResponse represents the response to a SetMonitoringModeRequest.
+// Results are the StatusCodes in the same order as the MonitoredItemIDs in the request.
+//
+// Specification: Part 4, 5.12.4.2
+type SetMonitoringModeResponse struct {
+	TypeID *datatypes.ExpandedNodeID
+	*ResponseHeader
+	Results         *datatypes.Uint32Array
+	DiagnosticInfos *DiagnosticInfoArray
+}
+
+// NewSetMonitoringModeResponse creates a new SetMonitoringModeResponse.
+func NewSetMonitoringModeResponse(ts time.Time, handle, code uint32, diag *DiagnosticInfo, strs []string, results []uint32, diags []*DiagnosticInfo) *SetMonitoringModeResponse {
+	return &SetMonitoringModeResponse{
+		TypeID: datatypes.NewExpandedNodeID(
+			false, false,
+			datatypes.NewFourByteNodeID(
+				0, ServiceTypeSetMonitoringModeResponse,
+			),
+			"", 0,
+		),
+		ResponseHeader: NewResponseHeader(
+			ts,
+			handle,
+			code,
+			diag,
+			strs,
+			NewAdditionalHeader(
+				datatypes.NewExpandedNodeID(
+					false, false,
+					datatypes.NewTwoByteNodeID(0),
+					"", 0,
+				),
+				0x00,
+			),
+			nil,
+		),
+		Results:         datatypes.NewUint32Array(results),
+		DiagnosticInfos: NewDiagnosticInfoArray(diags),
+	}
+}
+
+// DecodeSetMonitoringModeResponse decodes given bytes into SetMonitoringModeResponse.
+func DecodeSetMonitoringModeResponse(b []byte) (*SetMonitoringModeResponse, error) {
+	s := &SetMonitoringModeResponse{}
+	if err := s.DecodeFromBytes(b); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// DecodeFromBytes decodes given bytes into SetMonitoringModeResponse.
+func (s *SetMonitoringModeResponse) DecodeFromBytes(b []byte) error {
+	offset := 0
+	s.TypeID = &datatypes.ExpandedNodeID{}
+	if err := s.TypeID.DecodeFromBytes(b[offset:]); err != nil {
+		return err
+	}
+	offset += s.TypeID.Len()
+
+	s.ResponseHeader = &ResponseHeader{}
+	if err := s.ResponseHeader.DecodeFromBytes(b[offset:]); err != nil {
+		return err
+	}
+	offset += s.ResponseHeader.Len() - len(s.ResponseHeader.Payload)
+
+	s.Results = &datatypes.Uint32Array{}
+	if err := s.Results.DecodeFromBytes(b[offset:]); err != nil {
+		return err
+	}
+	offset += s.Results.Len()
+
+	s.DiagnosticInfos = &DiagnosticInfoArray{}
+	return s.DiagnosticInfos.DecodeFromBytes(b[offset:])
+}
+
+// Serialize serializes SetMonitoringModeResponse into bytes.
+func (s *SetMonitoringModeResponse) Serialize() ([]byte, error) {
+	b := make([]byte, s.Len())
+	if err := s.SerializeTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// SerializeTo serializes SetMonitoringModeResponse into bytes.
+func (s *SetMonitoringModeResponse) SerializeTo(b []byte) error {
+	offset := 0
+	if s.TypeID != nil {
+		if err := s.TypeID.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += s.TypeID.Len()
+	}
+
+	if s.ResponseHeader != nil {
+		if err := s.ResponseHeader.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += s.ResponseHeader.Len() - len(s.Payload)
+	}
+
+	if s.Results != nil {
+		if err := s.Results.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += s.Results.Len()
+	}
+
+	if s.DiagnosticInfos != nil {
+		return s.DiagnosticInfos.SerializeTo(b[offset:])
+	}
+
+	return nil
+}
+
+// Len returns the actual length of SetMonitoringModeResponse in int.
+func (s *SetMonitoringModeResponse) Len() int {
+	l := 0
+	if s.TypeID != nil {
+		l += s.TypeID.Len()
+	}
+	if s.ResponseHeader != nil {
+		l += (s.ResponseHeader.Len() - len(s.Payload))
+	}
+	if s.Results != nil {
+		l += s.Results.Len()
+	}
+	if s.DiagnosticInfos != nil {
+		l += s.DiagnosticInfos.Len()
+	}
+
+	return l
+}
+
+// ServiceType returns type of Service in uint16.
+func (s *SetMonitoringModeResponse) ServiceType() uint16 {
+	return ServiceTypeSetMonitoringModeResponse
+}
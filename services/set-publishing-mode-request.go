@@ -0,0 +1,153 @@
+// Copyright 2018 gopcua authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package services
+
+import (
+	"time"
+
+	"github.com/wmnsk/gopcua/datatypes"
+)
+
+// SetPublishingModeRequest is used to enable sending of Notifications on one or more Subscriptions.
+//
+// Specification: Part 4, 5.13.4.2
+type SetPublishingModeRequest struct {
+	TypeID *datatypes.ExpandedNodeID
+	*RequestHeader
+	PublishingEnabled *datatypes.Boolean
+	SubscriptionIDs   *datatypes.Uint32Array
+}
+
+// NewSetPublishingModeRequest creates a new SetPublishingModeRequest.
+func NewSetPublishingModeRequest(ts time.Time, authToken datatypes.NodeID, handle, diag, timeout uint32, auditID string, enabled bool, ids ...uint32) *SetPublishingModeRequest {
+	return &SetPublishingModeRequest{
+		TypeID: datatypes.NewExpandedNodeID(
+			false, false,
+			datatypes.NewFourByteNodeID(
+				0, ServiceTypeSetPublishingModeRequest,
+			),
+			"", 0,
+		),
+		RequestHeader: NewRequestHeader(
+			authToken,
+			ts,
+			handle,
+			diag,
+			timeout,
+			auditID,
+			NewAdditionalHeader(
+				datatypes.NewExpandedNodeID(
+					false, false,
+					datatypes.NewTwoByteNodeID(0),
+					"", 0,
+				),
+				0x00,
+			),
+			nil,
+		),
+		PublishingEnabled: datatypes.NewBoolean(enabled),
+		SubscriptionIDs:   datatypes.NewUint32Array(ids),
+	}
+}
+
+// DecodeSetPublishingModeRequest decodes given bytes into SetPublishingModeRequest.
+func DecodeSetPublishingModeRequest(b []byte) (*SetPublishingModeRequest, error) {
+	s := &SetPublishingModeRequest{}
+	if err := s.DecodeFromBytes(b); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// DecodeFromBytes decodes given bytes into SetPublishingModeRequest.
+func (s *SetPublishingModeRequest) DecodeFromBytes(b []byte) error {
+	offset := 0
+	s.TypeID = &datatypes.ExpandedNodeID{}
+	if err := s.TypeID.DecodeFromBytes(b[offset:]); err != nil {
+		return err
+	}
+	offset += s.TypeID.Len()
+
+	s.RequestHeader = &RequestHeader{}
+	if err := s.RequestHeader.DecodeFromBytes(b[offset:]); err != nil {
+		return err
+	}
+	offset += s.RequestHeader.Len() - len(s.RequestHeader.Payload)
+
+	s.PublishingEnabled = &datatypes.Boolean{}
+	if err := s.PublishingEnabled.DecodeFromBytes(b[offset:]); err != nil {
+		return err
+	}
+	offset += s.PublishingEnabled.Len()
+
+	s.SubscriptionIDs = &datatypes.Uint32Array{}
+	return s.SubscriptionIDs.DecodeFromBytes(b[offset:])
+}
+
+// Serialize serializes SetPublishingModeRequest into bytes.
+func (s *SetPublishingModeRequest) Serialize() ([]byte, error) {
+	b := make([]byte, s.Len())
+	if err := s.SerializeTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// SerializeTo serializes SetPublishingModeRequest into bytes.
+func (s *SetPublishingModeRequest) SerializeTo(b []byte) error {
+	offset := 0
+	if s.TypeID != nil {
+		if err := s.TypeID.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += s.TypeID.Len()
+	}
+
+	if s.RequestHeader != nil {
+		if err := s.RequestHeader.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += s.RequestHeader.Len() - len(s.Payload)
+	}
+
+	if s.PublishingEnabled != nil {
+		if err := s.PublishingEnabled.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += s.PublishingEnabled.Len()
+	}
+
+	if s.SubscriptionIDs != nil {
+		return s.SubscriptionIDs.SerializeTo(b[offset:])
+	}
+
+	return nil
+}
+
+// Len returns the actual length of SetPublishingModeRequest in int.
+func (s *SetPublishingModeRequest) Len() int {
+	l := 0
+	if s.TypeID != nil {
+		l += s.TypeID.Len()
+	}
+	if s.RequestHeader != nil {
+		l += (s.RequestHeader.Len() - len(s.Payload))
+	}
+	if s.PublishingEnabled != nil {
+		l += s.PublishingEnabled.Len()
+	}
+	if s.SubscriptionIDs != nil {
+		l += s.SubscriptionIDs.Len()
+	}
+
+	return l
+}
+
+// ServiceType returns type of Service in uint16.
+func (s *SetPublishingModeRequest) ServiceType() uint16 {
+	return ServiceTypeSetPublishingModeRequest
+}
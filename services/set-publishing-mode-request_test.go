@@ -0,0 +1,106 @@
+// Copyright 2018 gopcua authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/wmnsk/gopcua/datatypes"
+)
+
+var setPublishingModeRequestCases = []struct {
+	description string
+	structured  *SetPublishingModeRequest
+	serialized  []byte
+}{
+	{
+		"normal",
+		NewSetPublishingModeRequest(
+			time.Date(2018, time.August, 10, 23, 0, 0, 0, time.UTC),
+			datatypes.NewTwoByteNodeID(0), 1, 0, 0, "",
+			true, 1, 2,
+		),
+		[]byte{ // SetPublishingModeRequest
+			// TypeID
+			0x01, 0x00, 0x1f, 0x03,
+			// RequestHeader
+			// AuthenticationToken
+			0x00, 0x00,
+			// Timestamp
+			0x00, 0x98, 0x67, 0xdd, 0xfd, 0x30, 0xd4, 0x01,
+			// RequestHandle
+			0x01, 0x00, 0x00, 0x00,
+			// ReturnDiagnostics
+			0x00, 0x00, 0x00, 0x00,
+			// AuditEntryID
+			0xff, 0xff, 0xff, 0xff,
+			// TimeoutHint
+			0x00, 0x00, 0x00, 0x00,
+			// AdditionalHeader
+			0x00, 0x00, 0x00,
+			// PublishingEnabled
+			0x01,
+			// SubscriptionIDs
+			// ArraySize
+			0x02, 0x00, 0x00, 0x00,
+			0x01, 0x00, 0x00, 0x00,
+			0x02, 0x00, 0x00, 0x00,
+		},
+	},
+}
+
+func TestDecodeSetPublishingModeRequest(t *testing.T) {
+	for _, c := range setPublishingModeRequestCases {
+		got, err := DecodeSetPublishingModeRequest(c.serialized)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// need to clear Payload here.
+		got.Payload = nil
+
+		if diff := cmp.Diff(got, c.structured, decodeCmpOpt); diff != "" {
+			t.Errorf("%s failed\n%s", c.description, diff)
+		}
+	}
+}
+
+func TestSerializeSetPublishingModeRequest(t *testing.T) {
+	for _, c := range setPublishingModeRequestCases {
+		got, err := c.structured.Serialize()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if diff := cmp.Diff(got, c.serialized); diff != "" {
+			t.Errorf("%s failed\n%s", c.description, diff)
+		}
+	}
+}
+
+func TestSetPublishingModeRequestLen(t *testing.T) {
+	for _, c := range setPublishingModeRequestCases {
+		got := c.structured.Len()
+
+		if diff := cmp.Diff(got, len(c.serialized)); diff != "" {
+			t.Errorf("%s failed\n%s", c.description, diff)
+		}
+	}
+}
+
+func TestSetPublishingModeRequestServiceType(t *testing.T) {
+	for _, c := range setPublishingModeRequestCases {
+		if c.structured.ServiceType() != ServiceTypeSetPublishingModeRequest {
+			t.Errorf(
+				"ServiceType doesn't match. Want: %d, Got: %d",
+				ServiceTypeSetPublishingModeRequest,
+				c.structured.ServiceType(),
+			)
+		}
+	}
+}
@@ -0,0 +1,152 @@
+// Copyright 2018 gopcua authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package services
+
+import (
+	"time"
+
+	"github.com/wmnsk/gopcua/datatypes"
+)
+
+// SetPublishingModeResponse represents the response to a SetPublishingModeRequest.
+//
+// Specification: Part 4, 5.13.4.2
+type SetPublishingModeResponse struct {
+	TypeID *datatypes.ExpandedNodeID
+	*ResponseHeader
+	Results         *datatypes.Uint32Array
+	DiagnosticInfos *DiagnosticInfoArray
+}
+
+// NewSetPublishingModeResponse creates a new SetPublishingModeResponse.
+func NewSetPublishingModeResponse(ts time.Time, handle, code uint32, diag *DiagnosticInfo, strs []string, results []uint32, diags []*DiagnosticInfo) *SetPublishingModeResponse {
+	return &SetPublishingModeResponse{
+		TypeID: datatypes.NewExpandedNodeID(
+			false, false,
+			datatypes.NewFourByteNodeID(
+				0, ServiceTypeSetPublishingModeResponse,
+			),
+			"", 0,
+		),
+		ResponseHeader: NewResponseHeader(
+			ts,
+			handle,
+			code,
+			diag,
+			strs,
+			NewAdditionalHeader(
+				datatypes.NewExpandedNodeID(
+					false, false,
+					datatypes.NewTwoByteNodeID(0),
+					"", 0,
+				),
+				0x00,
+			),
+			nil,
+		),
+		Results:         datatypes.NewUint32Array(results),
+		DiagnosticInfos: NewDiagnosticInfoArray(diags),
+	}
+}
+
+// DecodeSetPublishingModeResponse decodes given bytes into SetPublishingModeResponse.
+func DecodeSetPublishingModeResponse(b []byte) (*SetPublishingModeResponse, error) {
+	s := &SetPublishingModeResponse{}
+	if err := s.DecodeFromBytes(b); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// DecodeFromBytes decodes given bytes into SetPublishingModeResponse.
+func (s *SetPublishingModeResponse) DecodeFromBytes(b []byte) error {
+	offset := 0
+	s.TypeID = &datatypes.ExpandedNodeID{}
+	if err := s.TypeID.DecodeFromBytes(b[offset:]); err != nil {
+		return err
+	}
+	offset += s.TypeID.Len()
+
+	s.ResponseHeader = &ResponseHeader{}
+	if err := s.ResponseHeader.DecodeFromBytes(b[offset:]); err != nil {
+		return err
+	}
+	offset += s.ResponseHeader.Len() - len(s.ResponseHeader.Payload)
+
+	s.Results = &datatypes.Uint32Array{}
+	if err := s.Results.DecodeFromBytes(b[offset:]); err != nil {
+		return err
+	}
+	offset += s.Results.Len()
+
+	s.DiagnosticInfos = &DiagnosticInfoArray{}
+	return s.DiagnosticInfos.DecodeFromBytes(b[offset:])
+}
+
+// Serialize serializes SetPublishingModeResponse into bytes.
+func (s *SetPublishingModeResponse) Serialize() ([]byte, error) {
+	b := make([]byte, s.Len())
+	if err := s.SerializeTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// SerializeTo serializes SetPublishingModeResponse into bytes.
+func (s *SetPublishingModeResponse) SerializeTo(b []byte) error {
+	offset := 0
+	if s.TypeID != nil {
+		if err := s.TypeID.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += s.TypeID.Len()
+	}
+
+	if s.ResponseHeader != nil {
+		if err := s.ResponseHeader.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += s.ResponseHeader.Len() - len(s.Payload)
+	}
+
+	if s.Results != nil {
+		if err := s.Results.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += s.Results.Len()
+	}
+
+	if s.DiagnosticInfos != nil {
+		return s.DiagnosticInfos.SerializeTo(b[offset:])
+	}
+
+	return nil
+}
+
+// Len returns the actual length of SetPublishingModeResponse in int.
+func (s *SetPublishingModeResponse) Len() int {
+	l := 0
+	if s.TypeID != nil {
+		l += s.TypeID.Len()
+	}
+	if s.ResponseHeader != nil {
+		l += (s.ResponseHeader.Len() - len(s.Payload))
+	}
+	if s.Results != nil {
+		l += s.Results.Len()
+	}
+	if s.DiagnosticInfos != nil {
+		l += s.DiagnosticInfos.Len()
+	}
+
+	return l
+}
+
+// ServiceType returns type of Service in uint16.
+func (s *SetPublishingModeResponse) ServiceType() uint16 {
+	return ServiceTypeSetPublishingModeResponse
+}
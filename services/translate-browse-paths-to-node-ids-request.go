@@ -0,0 +1,137 @@
+// Copyright 2018 gopcua authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package services
+
+import (
+	"time"
+
+	"github.com/wmnsk/gopcua/datatypes"
+)
+
+// TranslateBrowsePathsToNodeIDsRequest is used to request the Server to translate
+// one or more browse paths to NodeIDs. Each browse path is constructed of a starting
+// Node and a RelativePath.
+//
+// Specification: Part 4, 5.8.4.2
+type TranslateBrowsePathsToNodeIDsRequest struct {
+	TypeID *datatypes.ExpandedNodeID
+	*RequestHeader
+	BrowsePaths *BrowsePathArray
+}
+
+// NewTranslateBrowsePathsToNodeIDsRequest creates a new TranslateBrowsePathsToNodeIDsRequest.
+func NewTranslateBrowsePathsToNodeIDsRequest(ts time.Time, authToken datatypes.NodeID, handle, diag, timeout uint32, auditID string, paths ...*BrowsePath) *TranslateBrowsePathsToNodeIDsRequest {
+	return &TranslateBrowsePathsToNodeIDsRequest{
+		TypeID: datatypes.NewExpandedNodeID(
+			false, false,
+			datatypes.NewFourByteNodeID(
+				0, ServiceTypeTranslateBrowsePathsToNodeIDsRequest,
+			),
+			"", 0,
+		),
+		RequestHeader: NewRequestHeader(
+			authToken,
+			ts,
+			handle,
+			diag,
+			timeout,
+			auditID,
+			NewAdditionalHeader(
+				datatypes.NewExpandedNodeID(
+					false, false,
+					datatypes.NewTwoByteNodeID(0),
+					"", 0,
+				),
+				0x00,
+			),
+			nil,
+		),
+		BrowsePaths: NewBrowsePathArray(paths),
+	}
+}
+
+// DecodeTranslateBrowsePathsToNodeIDsRequest decodes given bytes into TranslateBrowsePathsToNodeIDsRequest.
+func DecodeTranslateBrowsePathsToNodeIDsRequest(b []byte) (*TranslateBrowsePathsToNodeIDsRequest, error) {
+	t := &TranslateBrowsePathsToNodeIDsRequest{}
+	if err := t.DecodeFromBytes(b); err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+// DecodeFromBytes decodes given bytes into TranslateBrowsePathsToNodeIDsRequest.
+func (t *TranslateBrowsePathsToNodeIDsRequest) DecodeFromBytes(b []byte) error {
+	offset := 0
+	t.TypeID = &datatypes.ExpandedNodeID{}
+	if err := t.TypeID.DecodeFromBytes(b[offset:]); err != nil {
+		return err
+	}
+	offset += t.TypeID.Len()
+
+	t.RequestHeader = &RequestHeader{}
+	if err := t.RequestHeader.DecodeFromBytes(b[offset:]); err != nil {
+		return err
+	}
+	offset += t.RequestHeader.Len() - len(t.RequestHeader.Payload)
+
+	t.BrowsePaths = &BrowsePathArray{}
+	return t.BrowsePaths.DecodeFromBytes(b[offset:])
+}
+
+// Serialize serializes TranslateBrowsePathsToNodeIDsRequest into bytes.
+func (t *TranslateBrowsePathsToNodeIDsRequest) Serialize() ([]byte, error) {
+	b := make([]byte, t.Len())
+	if err := t.SerializeTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// SerializeTo serializes TranslateBrowsePathsToNodeIDsRequest into bytes.
+func (t *TranslateBrowsePathsToNodeIDsRequest) SerializeTo(b []byte) error {
+	offset := 0
+	if t.TypeID != nil {
+		if err := t.TypeID.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += t.TypeID.Len()
+	}
+
+	if t.RequestHeader != nil {
+		if err := t.RequestHeader.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += t.RequestHeader.Len() - len(t.Payload)
+	}
+
+	if t.BrowsePaths != nil {
+		return t.BrowsePaths.SerializeTo(b[offset:])
+	}
+
+	return nil
+}
+
+// Len returns the actual length of TranslateBrowsePathsToNodeIDsRequest.
+func (t *TranslateBrowsePathsToNodeIDsRequest) Len() int {
+	l := 0
+	if t.TypeID != nil {
+		l += t.TypeID.Len()
+	}
+	if t.RequestHeader != nil {
+		l += (t.RequestHeader.Len() - len(t.Payload))
+	}
+	if t.BrowsePaths != nil {
+		l += t.BrowsePaths.Len()
+	}
+
+	return l
+}
+
+// ServiceType returns type of Service in uint16.
+func (t *TranslateBrowsePathsToNodeIDsRequest) ServiceType() uint16 {
+	return ServiceTypeTranslateBrowsePathsToNodeIDsRequest
+}
@@ -0,0 +1,123 @@
+// Copyright 2018 gopcua authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/wmnsk/gopcua/datatypes"
+	"github.com/wmnsk/gopcua/id"
+)
+
+var translateBrowsePathsToNodeIDsRequestCases = []struct {
+	description string
+	structured  *TranslateBrowsePathsToNodeIDsRequest
+	serialized  []byte
+}{
+	{
+		"normal",
+		NewTranslateBrowsePathsToNodeIDsRequest(
+			time.Date(2018, time.August, 10, 23, 0, 0, 0, time.UTC),
+			datatypes.NewTwoByteNodeID(0), 1, 0, 0, "",
+			NewBrowsePath(
+				datatypes.NewTwoByteNodeID(id.RootFolder),
+				NewRelativePath([]*RelativePathElement{
+					NewRelativePathElement(
+						datatypes.NewTwoByteNodeID(id.HierarchicalReferences),
+						false, true, 0, "Objects",
+					),
+				}),
+			),
+		),
+		[]byte{ // TranslateBrowsePathsToNodeIDsRequest
+			// TypeID
+			0x01, 0x00, 0x2a, 0x02,
+			// RequestHeader
+			// AuthenticationToken
+			0x00, 0x00,
+			// Timestamp
+			0x00, 0x98, 0x67, 0xdd, 0xfd, 0x30, 0xd4, 0x01,
+			// RequestHandle
+			0x01, 0x00, 0x00, 0x00,
+			// ReturnDiagnostics
+			0x00, 0x00, 0x00, 0x00,
+			// AuditEntryID
+			0xff, 0xff, 0xff, 0xff,
+			// TimeoutHint
+			0x00, 0x00, 0x00, 0x00,
+			// AdditionalHeader
+			0x00, 0x00, 0x00,
+			// BrowsePaths: ArraySize
+			0x01, 0x00, 0x00, 0x00,
+			// StartingNode
+			0x00, 0x54,
+			// RelativePath: ArraySize
+			0x01, 0x00, 0x00, 0x00,
+			// ReferenceTypeID
+			0x00, 0x21,
+			// IsInverse
+			0x00,
+			// IncludeSubtypes
+			0x01,
+			// TargetName
+			0x00, 0x00, 0x07, 0x00, 0x00, 0x00, 0x4f, 0x62,
+			0x6a, 0x65, 0x63, 0x74, 0x73,
+		},
+	},
+}
+
+func TestDecodeTranslateBrowsePathsToNodeIDsRequest(t *testing.T) {
+	for _, c := range translateBrowsePathsToNodeIDsRequestCases {
+		got, err := DecodeTranslateBrowsePathsToNodeIDsRequest(c.serialized)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// need to clear Payload here.
+		got.Payload = nil
+
+		if diff := cmp.Diff(got, c.structured, decodeCmpOpt); diff != "" {
+			t.Errorf("%s failed\n%s", c.description, diff)
+		}
+	}
+}
+
+func TestSerializeTranslateBrowsePathsToNodeIDsRequest(t *testing.T) {
+	for _, c := range translateBrowsePathsToNodeIDsRequestCases {
+		got, err := c.structured.Serialize()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if diff := cmp.Diff(got, c.serialized); diff != "" {
+			t.Errorf("%s failed\n%s", c.description, diff)
+		}
+	}
+}
+
+func TestTranslateBrowsePathsToNodeIDsRequestLen(t *testing.T) {
+	for _, c := range translateBrowsePathsToNodeIDsRequestCases {
+		got := c.structured.Len()
+
+		if diff := cmp.Diff(got, len(c.serialized)); diff != "" {
+			t.Errorf("%s failed\n%s", c.description, diff)
+		}
+	}
+}
+
+func TestTranslateBrowsePathsToNodeIDsRequestServiceType(t *testing.T) {
+	for _, c := range translateBrowsePathsToNodeIDsRequestCases {
+		if c.structured.ServiceType() != ServiceTypeTranslateBrowsePathsToNodeIDsRequest {
+			t.Errorf(
+				"ServiceType doesn't match. Want: %d, Got: %d",
+				ServiceTypeTranslateBrowsePathsToNodeIDsRequest,
+				c.structured.ServiceType(),
+			)
+		}
+	}
+}
@@ -0,0 +1,153 @@
+// Copyright 2018 gopcua authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package services
+
+import (
+	"time"
+
+	"github.com/wmnsk/gopcua/datatypes"
+)
+
+// TranslateBrowsePathsToNodeIDsResponse represents the response to a TranslateBrowsePathsToNodeIDsRequest.
+// The Results are in the same order as the BrowsePaths in the request.
+//
+// Specification: Part 4, 5.8.4.2
+type TranslateBrowsePathsToNodeIDsResponse struct {
+	TypeID *datatypes.ExpandedNodeID
+	*ResponseHeader
+	Results         *BrowsePathResultArray
+	DiagnosticInfos *DiagnosticInfoArray
+}
+
+// NewTranslateBrowsePathsToNodeIDsResponse creates a new TranslateBrowsePathsToNodeIDsResponse.
+func NewTranslateBrowsePathsToNodeIDsResponse(ts time.Time, handle, code uint32, diag *DiagnosticInfo, strs []string, results []*BrowsePathResult, diags []*DiagnosticInfo) *TranslateBrowsePathsToNodeIDsResponse {
+	return &TranslateBrowsePathsToNodeIDsResponse{
+		TypeID: datatypes.NewExpandedNodeID(
+			false, false,
+			datatypes.NewFourByteNodeID(
+				0, ServiceTypeTranslateBrowsePathsToNodeIDsResponse,
+			),
+			"", 0,
+		),
+		ResponseHeader: NewResponseHeader(
+			ts,
+			handle,
+			code,
+			diag,
+			strs,
+			NewAdditionalHeader(
+				datatypes.NewExpandedNodeID(
+					false, false,
+					datatypes.NewTwoByteNodeID(0),
+					"", 0,
+				),
+				0x00,
+			),
+			nil,
+		),
+		Results:         NewBrowsePathResultArray(results),
+		DiagnosticInfos: NewDiagnosticInfoArray(diags),
+	}
+}
+
+// DecodeTranslateBrowsePathsToNodeIDsResponse decodes given bytes into TranslateBrowsePathsToNodeIDsResponse.
+func DecodeTranslateBrowsePathsToNodeIDsResponse(b []byte) (*TranslateBrowsePathsToNodeIDsResponse, error) {
+	t := &TranslateBrowsePathsToNodeIDsResponse{}
+	if err := t.DecodeFromBytes(b); err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+// DecodeFromBytes decodes given bytes into TranslateBrowsePathsToNodeIDsResponse.
+func (t *TranslateBrowsePathsToNodeIDsResponse) DecodeFromBytes(b []byte) error {
+	offset := 0
+	t.TypeID = &datatypes.ExpandedNodeID{}
+	if err := t.TypeID.DecodeFromBytes(b[offset:]); err != nil {
+		return err
+	}
+	offset += t.TypeID.Len()
+
+	t.ResponseHeader = &ResponseHeader{}
+	if err := t.ResponseHeader.DecodeFromBytes(b[offset:]); err != nil {
+		return err
+	}
+	offset += t.ResponseHeader.Len() - len(t.ResponseHeader.Payload)
+
+	t.Results = &BrowsePathResultArray{}
+	if err := t.Results.DecodeFromBytes(b[offset:]); err != nil {
+		return err
+	}
+	offset += t.Results.Len()
+
+	t.DiagnosticInfos = &DiagnosticInfoArray{}
+	return t.DiagnosticInfos.DecodeFromBytes(b[offset:])
+}
+
+// Serialize serializes TranslateBrowsePathsToNodeIDsResponse into bytes.
+func (t *TranslateBrowsePathsToNodeIDsResponse) Serialize() ([]byte, error) {
+	b := make([]byte, t.Len())
+	if err := t.SerializeTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// SerializeTo serializes TranslateBrowsePathsToNodeIDsResponse into bytes.
+func (t *TranslateBrowsePathsToNodeIDsResponse) SerializeTo(b []byte) error {
+	offset := 0
+	if t.TypeID != nil {
+		if err := t.TypeID.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += t.TypeID.Len()
+	}
+
+	if t.ResponseHeader != nil {
+		if err := t.ResponseHeader.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += t.ResponseHeader.Len() - len(t.Payload)
+	}
+
+	if t.Results != nil {
+		if err := t.Results.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += t.Results.Len()
+	}
+
+	if t.DiagnosticInfos != nil {
+		return t.DiagnosticInfos.SerializeTo(b[offset:])
+	}
+
+	return nil
+}
+
+// Len returns the actual length of TranslateBrowsePathsToNodeIDsResponse.
+func (t *TranslateBrowsePathsToNodeIDsResponse) Len() int {
+	l := 0
+	if t.TypeID != nil {
+		l += t.TypeID.Len()
+	}
+	if t.ResponseHeader != nil {
+		l += (t.ResponseHeader.Len() - len(t.Payload))
+	}
+	if t.Results != nil {
+		l += t.Results.Len()
+	}
+	if t.DiagnosticInfos != nil {
+		l += t.DiagnosticInfos.Len()
+	}
+
+	return l
+}
+
+// ServiceType returns type of Service in uint16.
+func (t *TranslateBrowsePathsToNodeIDsResponse) ServiceType() uint16 {
+	return ServiceTypeTranslateBrowsePathsToNodeIDsResponse
+}
@@ -0,0 +1,122 @@
+// Copyright 2018 gopcua authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/wmnsk/gopcua/datatypes"
+)
+
+var translateBrowsePathsToNodeIDsResponseCases = []struct {
+	description string
+	structured  *TranslateBrowsePathsToNodeIDsResponse
+	serialized  []byte
+}{
+	{
+		"normal",
+		NewTranslateBrowsePathsToNodeIDsResponse(
+			time.Date(2018, time.August, 10, 23, 0, 0, 0, time.UTC),
+			1, 0, nil, nil,
+			[]*BrowsePathResult{
+				NewBrowsePathResult(
+					0,
+					NewBrowsePathTarget(
+						datatypes.NewExpandedNodeID(
+							false, false,
+							datatypes.NewFourByteNodeID(2, 10),
+							"", 0,
+						),
+						0xffffffff,
+					),
+				),
+			},
+			nil,
+		),
+		[]byte{ // TranslateBrowsePathsToNodeIDsResponse
+			// TypeID
+			0x01, 0x00, 0x2d, 0x02,
+			// ResponseHeader
+			// Timestamp
+			0x00, 0x98, 0x67, 0xdd, 0xfd, 0x30, 0xd4, 0x01,
+			// RequestHandle
+			0x01, 0x00, 0x00, 0x00,
+			// ServiceResult
+			0x00, 0x00, 0x00, 0x00,
+			// ServiceDiagnostics
+			0x00,
+			// StringTable
+			0x00, 0x00, 0x00, 0x00,
+			// AdditionalHeader
+			0x00, 0x00, 0x00,
+			// Results: ArraySize
+			0x01, 0x00, 0x00, 0x00,
+			// StatusCode
+			0x00, 0x00, 0x00, 0x00,
+			// Targets: ArraySize
+			0x01, 0x00, 0x00, 0x00,
+			// TargetID
+			0x01, 0x02, 0x0a, 0x00,
+			// RemainingPathIndex
+			0xff, 0xff, 0xff, 0xff,
+			// DiagnosticInfos
+			0x00, 0x00, 0x00, 0x00,
+		},
+	},
+}
+
+func TestDecodeTranslateBrowsePathsToNodeIDsResponse(t *testing.T) {
+	for _, c := range translateBrowsePathsToNodeIDsResponseCases {
+		got, err := DecodeTranslateBrowsePathsToNodeIDsResponse(c.serialized)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// need to clear Payload here.
+		got.Payload = nil
+
+		if diff := cmp.Diff(got, c.structured, decodeCmpOpt); diff != "" {
+			t.Errorf("%s failed\n%s", c.description, diff)
+		}
+	}
+}
+
+func TestSerializeTranslateBrowsePathsToNodeIDsResponse(t *testing.T) {
+	for _, c := range translateBrowsePathsToNodeIDsResponseCases {
+		got, err := c.structured.Serialize()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if diff := cmp.Diff(got, c.serialized); diff != "" {
+			t.Errorf("%s failed\n%s", c.description, diff)
+		}
+	}
+}
+
+func TestTranslateBrowsePathsToNodeIDsResponseLen(t *testing.T) {
+	for _, c := range translateBrowsePathsToNodeIDsResponseCases {
+		got := c.structured.Len()
+
+		if diff := cmp.Diff(got, len(c.serialized)); diff != "" {
+			t.Errorf("%s failed\n%s", c.description, diff)
+		}
+	}
+}
+
+func TestTranslateBrowsePathsToNodeIDsResponseServiceType(t *testing.T) {
+	for _, c := range translateBrowsePathsToNodeIDsResponseCases {
+		if c.structured.ServiceType() != ServiceTypeTranslateBrowsePathsToNodeIDsResponse {
+			t.Errorf(
+				"ServiceType doesn't match. Want: %d, Got: %d",
+				ServiceTypeTranslateBrowsePathsToNodeIDsResponse,
+				c.structured.ServiceType(),
+			)
+		}
+	}
+}
@@ -0,0 +1,136 @@
+// Copyright 2018 gopcua authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package services
+
+import (
+	"time"
+
+	"github.com/wmnsk/gopcua/datatypes"
+)
+
+// UnregisterNodesRequest is used to unregister NodeIDs that have been obtained
+// via the RegisterNodes service.
+//
+// Specification: Part 4, 5.8.6.2
+type UnregisterNodesRequest struct {
+	TypeID *datatypes.ExpandedNodeID
+	*RequestHeader
+	NodesToUnregister *datatypes.NodeIDArray
+}
+
+// NewUnregisterNodesRequest creates a new UnregisterNodesRequest.
+func NewUnregisterNodesRequest(ts time.Time, authToken datatypes.NodeID, handle, diag, timeout uint32, auditID string, nodes []datatypes.NodeID) *UnregisterNodesRequest {
+	return &UnregisterNodesRequest{
+		TypeID: datatypes.NewExpandedNodeID(
+			false, false,
+			datatypes.NewFourByteNodeID(
+				0, ServiceTypeUnregisterNodesRequest,
+			),
+			"", 0,
+		),
+		RequestHeader: NewRequestHeader(
+			authToken,
+			ts,
+			handle,
+			diag,
+			timeout,
+			auditID,
+			NewAdditionalHeader(
+				datatypes.NewExpandedNodeID(
+					false, false,
+					datatypes.NewTwoByteNodeID(0),
+					"", 0,
+				),
+				0x00,
+			),
+			nil,
+		),
+		NodesToUnregister: datatypes.NewNodeIDArray(nodes),
+	}
+}
+
+// DecodeUnregisterNodesRequest decodes given bytes into UnregisterNodesRequest.
+func DecodeUnregisterNodesRequest(b []byte) (*UnregisterNodesRequest, error) {
+	u := &UnregisterNodesRequest{}
+	if err := u.DecodeFromBytes(b); err != nil {
+		return nil, err
+	}
+
+	return u, nil
+}
+
+// DecodeFromBytes decodes given bytes into UnregisterNodesRequest.
+func (u *UnregisterNodesRequest) DecodeFromBytes(b []byte) error {
+	offset := 0
+	u.TypeID = &datatypes.ExpandedNodeID{}
+	if err := u.TypeID.DecodeFromBytes(b[offset:]); err != nil {
+		return err
+	}
+	offset += u.TypeID.Len()
+
+	u.RequestHeader = &RequestHeader{}
+	if err := u.RequestHeader.DecodeFromBytes(b[offset:]); err != nil {
+		return err
+	}
+	offset += u.RequestHeader.Len() - len(u.RequestHeader.Payload)
+
+	u.NodesToUnregister = &datatypes.NodeIDArray{}
+	return u.NodesToUnregister.DecodeFromBytes(b[offset:])
+}
+
+// Serialize serializes UnregisterNodesRequest into bytes.
+func (u *UnregisterNodesRequest) Serialize() ([]byte, error) {
+	b := make([]byte, u.Len())
+	if err := u.SerializeTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// SerializeTo serializes UnregisterNodesRequest into bytes.
+func (u *UnregisterNodesRequest) SerializeTo(b []byte) error {
+	offset := 0
+	if u.TypeID != nil {
+		if err := u.TypeID.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += u.TypeID.Len()
+	}
+
+	if u.RequestHeader != nil {
+		if err := u.RequestHeader.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += u.RequestHeader.Len() - len(u.Payload)
+	}
+
+	if u.NodesToUnregister != nil {
+		return u.NodesToUnregister.SerializeTo(b[offset:])
+	}
+
+	return nil
+}
+
+// Len returns the actual length of UnregisterNodesRequest in int.
+func (u *UnregisterNodesRequest) Len() int {
+	l := 0
+	if u.TypeID != nil {
+		l += u.TypeID.Len()
+	}
+	if u.RequestHeader != nil {
+		l += (u.RequestHeader.Len() - len(u.Payload))
+	}
+	if u.NodesToUnregister != nil {
+		l += u.NodesToUnregister.Len()
+	}
+
+	return l
+}
+
+// ServiceType returns type of Service in uint16.
+func (u *UnregisterNodesRequest) ServiceType() uint16 {
+	return ServiceTypeUnregisterNodesRequest
+}
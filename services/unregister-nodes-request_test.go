@@ -0,0 +1,110 @@
+// Copyright 2018 gopcua authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/wmnsk/gopcua/datatypes"
+)
+
+var unregisterNodesRequestCases = []struct {
+	description string
+	structured  *UnregisterNodesRequest
+	serialized  []byte
+}{
+	{
+		"normal",
+		NewUnregisterNodesRequest(
+			time.Date(2018, time.August, 10, 23, 0, 0, 0, time.UTC),
+			datatypes.NewTwoByteNodeID(0), 1, 0, 0, "",
+			[]datatypes.NodeID{
+				datatypes.NewFourByteNodeID(2, 10),
+				datatypes.NewStringNodeID(2, "foo"),
+			},
+		),
+		[]byte{ // UnregisterNodesRequest
+			// TypeID
+			0x01, 0x00, 0x36, 0x02,
+			// RequestHeader
+			// AuthenticationToken
+			0x00, 0x00,
+			// Timestamp
+			0x00, 0x98, 0x67, 0xdd, 0xfd, 0x30, 0xd4, 0x01,
+			// RequestHandle
+			0x01, 0x00, 0x00, 0x00,
+			// ReturnDiagnostics
+			0x00, 0x00, 0x00, 0x00,
+			// AuditEntryID
+			0xff, 0xff, 0xff, 0xff,
+			// TimeoutHint
+			0x00, 0x00, 0x00, 0x00,
+			// AdditionalHeader
+			0x00, 0x00, 0x00,
+			// NodesToUnregister
+			// ArraySize
+			0x02, 0x00, 0x00, 0x00,
+			// NodeID: FourByte
+			0x01, 0x02, 0x0a, 0x00,
+			// NodeID: String
+			0x03, 0x02, 0x00, 0x03, 0x00, 0x00, 0x00, 0x66,
+			0x6f, 0x6f,
+		},
+	},
+}
+
+func TestDecodeUnregisterNodesRequest(t *testing.T) {
+	for _, c := range unregisterNodesRequestCases {
+		got, err := DecodeUnregisterNodesRequest(c.serialized)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// need to clear Payload here.
+		got.Payload = nil
+
+		if diff := cmp.Diff(got, c.structured, decodeCmpOpt); diff != "" {
+			t.Errorf("%s failed\n%s", c.description, diff)
+		}
+	}
+}
+
+func TestSerializeUnregisterNodesRequest(t *testing.T) {
+	for _, c := range unregisterNodesRequestCases {
+		got, err := c.structured.Serialize()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if diff := cmp.Diff(got, c.serialized); diff != "" {
+			t.Errorf("%s failed\n%s", c.description, diff)
+		}
+	}
+}
+
+func TestUnregisterNodesRequestLen(t *testing.T) {
+	for _, c := range unregisterNodesRequestCases {
+		got := c.structured.Len()
+
+		if diff := cmp.Diff(got, len(c.serialized)); diff != "" {
+			t.Errorf("%s failed\n%s", c.description, diff)
+		}
+	}
+}
+
+func TestUnregisterNodesRequestServiceType(t *testing.T) {
+	for _, c := range unregisterNodesRequestCases {
+		if c.structured.ServiceType() != ServiceTypeUnregisterNodesRequest {
+			t.Errorf(
+				"ServiceType doesn't match. Want: %d, Got: %d",
+				ServiceTypeUnregisterNodesRequest,
+				c.structured.ServiceType(),
+			)
+		}
+	}
+}
@@ -0,0 +1,120 @@
+// Copyright 2018 gopcua authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package services
+
+import (
+	"time"
+
+	"github.com/wmnsk/gopcua/datatypes"
+)
+
+// UnregisterNodesResponse represents the response to an UnregisterNodesRequest.
+//
+// Specification: Part 4, 5.8.6.2
+type UnregisterNodesResponse struct {
+	TypeID *datatypes.ExpandedNodeID
+	*ResponseHeader
+}
+
+// NewUnregisterNodesResponse creates a new UnregisterNodesResponse.
+func NewUnregisterNodesResponse(ts time.Time, handle, code uint32, diag *DiagnosticInfo, strs []string) *UnregisterNodesResponse {
+	return &UnregisterNodesResponse{
+		TypeID: datatypes.NewExpandedNodeID(
+			false, false,
+			datatypes.NewFourByteNodeID(
+				0, ServiceTypeUnregisterNodesResponse,
+			),
+			"", 0,
+		),
+		ResponseHeader: NewResponseHeader(
+			ts,
+			handle,
+			code,
+			diag,
+			strs,
+			NewAdditionalHeader(
+				datatypes.NewExpandedNodeID(
+					false, false,
+					datatypes.NewTwoByteNodeID(0),
+					"", 0,
+				),
+				0x00,
+			),
+			nil,
+		),
+	}
+}
+
+// DecodeUnregisterNodesResponse decodes given bytes into UnregisterNodesResponse.
+func DecodeUnregisterNodesResponse(b []byte) (*UnregisterNodesResponse, error) {
+	u := &UnregisterNodesResponse{}
+	if err := u.DecodeFromBytes(b); err != nil {
+		return nil, err
+	}
+
+	return u, nil
+}
+
+// DecodeFromBytes decodes given bytes into UnregisterNodesResponse.
+func (u *UnregisterNodesResponse) DecodeFromBytes(b []byte) error {
+	offset := 0
+	u.TypeID = &datatypes.ExpandedNodeID{}
+	if err := u.TypeID.DecodeFromBytes(b[offset:]); err != nil {
+		return err
+	}
+	offset += u.TypeID.Len()
+
+	u.ResponseHeader = &ResponseHeader{}
+	if err := u.ResponseHeader.DecodeFromBytes(b[offset:]); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Serialize serializes UnregisterNodesResponse into bytes.
+func (u *UnregisterNodesResponse) Serialize() ([]byte, error) {
+	b := make([]byte, u.Len())
+	if err := u.SerializeTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// SerializeTo serializes UnregisterNodesResponse into bytes.
+func (u *UnregisterNodesResponse) SerializeTo(b []byte) error {
+	offset := 0
+	if u.TypeID != nil {
+		if err := u.TypeID.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += u.TypeID.Len()
+	}
+
+	if u.ResponseHeader != nil {
+		return u.ResponseHeader.SerializeTo(b[offset:])
+	}
+
+	return nil
+}
+
+// Len returns the actual length of UnregisterNodesResponse in int.
+func (u *UnregisterNodesResponse) Len() int {
+	l := 0
+	if u.TypeID != nil {
+		l += u.TypeID.Len()
+	}
+	if u.ResponseHeader != nil {
+		l += (u.ResponseHeader.Len() - len(u.Payload))
+	}
+
+	return l
+}
+
+// ServiceType returns type of Service in uint16.
+func (u *UnregisterNodesResponse) ServiceType() uint16 {
+	return ServiceTypeUnregisterNodesResponse
+}
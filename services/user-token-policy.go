@@ -9,6 +9,8 @@ import (
 	"fmt"
 
 	"github.com/wmnsk/gopcua/datatypes"
+	"github.com/wmnsk/gopcua/errors"
+	"github.com/wmnsk/gopcua/id"
 )
 
 // UserIdentityToken structure used in the Server Service Set allows Clients to specify the
@@ -253,3 +255,45 @@ func (u *UserTokenPolicyArray) Len() int {
 	}
 	return l
 }
+
+// Select returns the first UserTokenPolicy with the given UserTokenType, e.g. UserTokenAnonymous.
+//
+// Each Server has its own PolicyIDs even for the same UserTokenType, so the PolicyID
+// in UserIdentityToken should be taken from the policy returned here.
+func (u *UserTokenPolicyArray) Select(tokenType uint32) (*UserTokenPolicy, error) {
+	for _, ut := range u.UserTokenPolicies {
+		if ut.TokenType == tokenType {
+			return ut, nil
+		}
+	}
+	return nil, errors.Errorf("no UserTokenPolicy with UserTokenType %d", tokenType)
+}
+
+// NewAnonymousIdentityToken creates a new AnonymousIdentityToken in ExtensionObject,
+// which can be used as UserIdentityToken in ActivateSessionRequest.
+//
+// Specification: Part 4, 7.36.3
+func NewAnonymousIdentityToken(policyID string) *datatypes.ExtensionObject {
+	return datatypes.NewExtensionObject(
+		datatypes.NewExpandedNodeID(
+			false, false,
+			datatypes.NewFourByteNodeID(0, id.AnonymousIdentityToken_Encoding_DefaultBinary),
+			"", 0,
+		),
+		0x01,
+		[]byte(policyID),
+	)
+}
+
+// NewAnonymousIdentityTokenFor creates a new AnonymousIdentityToken with the PolicyID
+// of the anonymous UserTokenPolicy in the given EndpointDescription.
+func NewAnonymousIdentityTokenFor(ep *EndpointDescription) (*datatypes.ExtensionObject, error) {
+	if ep == nil || ep.UserIdentityTokens == nil {
+		return nil, errors.New("no UserTokenPolicy in EndpointDescription")
+	}
+	ut, err := ep.UserIdentityTokens.Select(UserTokenAnonymous)
+	if err != nil {
+		return nil, err
+	}
+	return NewAnonymousIdentityToken(ut.PolicyID.Get()), nil
+}
@@ -4,7 +4,13 @@
 
 package services
 
-import "testing"
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/wmnsk/gopcua/datatypes"
+)
 
 var testUserTokenPolicyBytes = [][]byte{
 	{ // Single
@@ -183,3 +189,42 @@ func TestSerializeUserTokenPolicy(t *testing.T) {
 		t.Logf("%x", serialized)
 	})
 }
+
+func TestNewAnonymousIdentityTokenFor(t *testing.T) {
+	ep := NewEndpointDescription(
+		"ep-url", nil, nil, SecModeNone, "", NewUserTokenPolicyArray(
+			[]*UserTokenPolicy{
+				NewUserTokenPolicy("username_basic256", UserTokenUsername, "", "", ""),
+				NewUserTokenPolicy("anonymous", UserTokenAnonymous, "", "", ""),
+			},
+		), "", 0,
+	)
+
+	got, err := NewAnonymousIdentityTokenFor(ep)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := datatypes.NewExtensionObject(
+		&datatypes.ExpandedNodeID{
+			NodeID: datatypes.NewFourByteNodeID(0, 321),
+		},
+		0x01,
+		[]byte("anonymous"),
+	)
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Error(diff)
+	}
+
+	t.Run("no-anonymous", func(t *testing.T) {
+		ep := NewEndpointDescription(
+			"ep-url", nil, nil, SecModeNone, "", NewUserTokenPolicyArray(
+				[]*UserTokenPolicy{
+					NewUserTokenPolicy("username_basic256", UserTokenUsername, "", "", ""),
+				},
+			), "", 0,
+		)
+		if _, err := NewAnonymousIdentityTokenFor(ep); err == nil {
+			t.Error("expected error")
+		}
+	})
+}
@@ -0,0 +1,307 @@
+// Copyright 2018 gopcua authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package services
+
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/wmnsk/gopcua/datatypes"
+	"github.com/wmnsk/gopcua/id"
+)
+
+func init() {
+	datatypes.RegisterExtensionObject(
+		datatypes.NewFourByteNodeID(0, id.VariableAttributes_Encoding_DefaultBinary),
+		func() datatypes.Data { return variableAttributesData{&VariableAttributes{}} },
+	)
+}
+
+// NodeAttributesMask definitions, which are used in SpecifiedAttributes of NodeAttributes.
+//
+// Specification: Part 4, 7.19.1
+const (
+	NodeAttributesMaskAccessLevel             uint32 = 0x00000001
+	NodeAttributesMaskArrayDimensions                = 0x00000002
+	NodeAttributesMaskBrowseName                     = 0x00000004
+	NodeAttributesMaskContainsNoLoops                = 0x00000008
+	NodeAttributesMaskDataType                       = 0x00000010
+	NodeAttributesMaskDescription                    = 0x00000020
+	NodeAttributesMaskDisplayName                    = 0x00000040
+	NodeAttributesMaskEventNotifier                  = 0x00000080
+	NodeAttributesMaskExecutable                     = 0x00000100
+	NodeAttributesMaskHistorizing                    = 0x00000200
+	NodeAttributesMaskInverseName                    = 0x00000400
+	NodeAttributesMaskIsAbstract                     = 0x00000800
+	NodeAttributesMaskMinimumSamplingInterval        = 0x00001000
+	NodeAttributesMaskNodeClass                      = 0x00002000
+	NodeAttributesMaskNodeID                         = 0x00004000
+	NodeAttributesMaskSymmetric                      = 0x00008000
+	NodeAttributesMaskUserAccessLevel                = 0x00010000
+	NodeAttributesMaskUserExecutable                 = 0x00020000
+	NodeAttributesMaskUserWriteMask                  = 0x00040000
+	NodeAttributesMaskValueRank                      = 0x00080000
+	NodeAttributesMaskWriteMask                      = 0x00100000
+	NodeAttributesMaskValue                          = 0x00200000
+)
+
+// VariableAttributes is the NodeAttributes of AddNodesItem to add a Variable Node.
+// Only the Attributes flagged in SpecifiedAttributes are used by the Server.
+//
+// Specification: Part 4, 7.19.4
+type VariableAttributes struct {
+	// Bit mask of NodeAttributesMask to indicate which Attributes are specified.
+	SpecifiedAttributes     uint32
+	DisplayName             *datatypes.LocalizedText
+	Description             *datatypes.LocalizedText
+	WriteMask               uint32
+	UserWriteMask           uint32
+	Value                   *datatypes.Variant
+	DataType                datatypes.NodeID
+	ValueRank               int32
+	ArrayDimensions         *datatypes.Uint32Array
+	AccessLevel             uint8
+	UserAccessLevel         uint8
+	MinimumSamplingInterval float64
+	Historizing             *datatypes.Boolean
+}
+
+// NewVariableAttributes creates a new VariableAttributes.
+func NewVariableAttributes(specified uint32, displayName *datatypes.LocalizedText, desc *datatypes.LocalizedText, writeMask uint32, userWriteMask uint32, value *datatypes.Variant, dataType datatypes.NodeID, valueRank int32, dims []uint32, accessLevel uint8, userAccessLevel uint8, interval float64, historizing bool) *VariableAttributes {
+	return &VariableAttributes{
+		SpecifiedAttributes:     specified,
+		DisplayName:             displayName,
+		Description:             desc,
+		WriteMask:               writeMask,
+		UserWriteMask:           userWriteMask,
+		Value:                   value,
+		DataType:                dataType,
+		ValueRank:               valueRank,
+		ArrayDimensions:         datatypes.NewUint32Array(dims),
+		AccessLevel:             accessLevel,
+		UserAccessLevel:         userAccessLevel,
+		MinimumSamplingInterval: interval,
+		Historizing:             datatypes.NewBoolean(historizing),
+	}
+}
+
+// DecodeVariableAttributes decodes given bytes into VariableAttributes.
+func DecodeVariableAttributes(b []byte) (*VariableAttributes, error) {
+	v := &VariableAttributes{}
+	if err := v.DecodeFromBytes(b); err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
+
+// DecodeFromBytes decodes given bytes into VariableAttributes.
+func (v *VariableAttributes) DecodeFromBytes(b []byte) error {
+	offset := 0
+	v.SpecifiedAttributes = binary.LittleEndian.Uint32(b[offset : offset+4])
+	offset += 4
+
+	v.DisplayName = &datatypes.LocalizedText{}
+	if err := v.DisplayName.DecodeFromBytes(b[offset:]); err != nil {
+		return err
+	}
+	offset += v.DisplayName.Len()
+
+	v.Description = &datatypes.LocalizedText{}
+	if err := v.Description.DecodeFromBytes(b[offset:]); err != nil {
+		return err
+	}
+	offset += v.Description.Len()
+
+	v.WriteMask = binary.LittleEndian.Uint32(b[offset : offset+4])
+	offset += 4
+
+	v.UserWriteMask = binary.LittleEndian.Uint32(b[offset : offset+4])
+	offset += 4
+
+	v.Value = &datatypes.Variant{}
+	if err := v.Value.DecodeFromBytes(b[offset:]); err != nil {
+		return err
+	}
+	offset += v.Value.Len()
+
+	dataType, err := datatypes.DecodeNodeID(b[offset:])
+	if err != nil {
+		return err
+	}
+	v.DataType = dataType
+	offset += v.DataType.Len()
+
+	v.ValueRank = int32(binary.LittleEndian.Uint32(b[offset : offset+4]))
+	offset += 4
+
+	v.ArrayDimensions = &datatypes.Uint32Array{}
+	if err := v.ArrayDimensions.DecodeFromBytes(b[offset:]); err != nil {
+		return err
+	}
+	offset += v.ArrayDimensions.Len()
+
+	v.AccessLevel = b[offset]
+	offset++
+
+	v.UserAccessLevel = b[offset]
+	offset++
+
+	v.MinimumSamplingInterval = math.Float64frombits(binary.LittleEndian.Uint64(b[offset : offset+8]))
+	offset += 8
+
+	v.Historizing = &datatypes.Boolean{}
+	return v.Historizing.DecodeFromBytes(b[offset:])
+}
+
+// Serialize serializes VariableAttributes into bytes.
+func (v *VariableAttributes) Serialize() ([]byte, error) {
+	b := make([]byte, v.Len())
+	if err := v.SerializeTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// SerializeTo serializes VariableAttributes into bytes.
+func (v *VariableAttributes) SerializeTo(b []byte) error {
+	offset := 0
+	binary.LittleEndian.PutUint32(b[offset:offset+4], v.SpecifiedAttributes)
+	offset += 4
+
+	if v.DisplayName != nil {
+		if err := v.DisplayName.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += v.DisplayName.Len()
+	}
+
+	if v.Description != nil {
+		if err := v.Description.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += v.Description.Len()
+	}
+
+	binary.LittleEndian.PutUint32(b[offset:offset+4], v.WriteMask)
+	offset += 4
+
+	binary.LittleEndian.PutUint32(b[offset:offset+4], v.UserWriteMask)
+	offset += 4
+
+	if v.Value != nil {
+		if err := v.Value.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += v.Value.Len()
+	}
+
+	if v.DataType != nil {
+		if err := v.DataType.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += v.DataType.Len()
+	}
+
+	binary.LittleEndian.PutUint32(b[offset:offset+4], uint32(v.ValueRank))
+	offset += 4
+
+	if v.ArrayDimensions != nil {
+		if err := v.ArrayDimensions.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += v.ArrayDimensions.Len()
+	}
+
+	b[offset] = v.AccessLevel
+	offset++
+
+	b[offset] = v.UserAccessLevel
+	offset++
+
+	binary.LittleEndian.PutUint64(b[offset:offset+8], math.Float64bits(v.MinimumSamplingInterval))
+	offset += 8
+
+	if v.Historizing != nil {
+		return v.Historizing.SerializeTo(b[offset:])
+	}
+
+	return nil
+}
+
+// Len returns the actual length of VariableAttributes in int.
+func (v *VariableAttributes) Len() int {
+	// SpecifiedAttributes + WriteMask + UserWriteMask + ValueRank + AccessLevel + UserAccessLevel + MinimumSamplingInterval
+	l := 26
+	if v.DisplayName != nil {
+		l += v.DisplayName.Len()
+	}
+	if v.Description != nil {
+		l += v.Description.Len()
+	}
+	if v.Value != nil {
+		l += v.Value.Len()
+	}
+	if v.DataType != nil {
+		l += v.DataType.Len()
+	}
+	if v.ArrayDimensions != nil {
+		l += v.ArrayDimensions.Len()
+	}
+	if v.Historizing != nil {
+		l += v.Historizing.Len()
+	}
+
+	return l
+}
+
+// NewScalarVariableAttributes creates a new VariableAttributes for a scalar Variable
+// which is readable and writable, with the DisplayName, DataType and initial Value.
+func NewScalarVariableAttributes(displayName string, dataType datatypes.NodeID, value *datatypes.Variant) *VariableAttributes {
+	return &VariableAttributes{
+		SpecifiedAttributes: NodeAttributesMaskDisplayName | NodeAttributesMaskValue | NodeAttributesMaskDataType |
+			NodeAttributesMaskValueRank | NodeAttributesMaskAccessLevel | NodeAttributesMaskUserAccessLevel,
+		DisplayName:     datatypes.NewLocalizedText("", displayName),
+		Description:     datatypes.NewLocalizedText("", ""),
+		Value:           value,
+		DataType:        dataType,
+		ValueRank:       -1,
+		ArrayDimensions: datatypes.NewUint32Array(nil),
+		// CurrentRead | CurrentWrite
+		AccessLevel:     0x03,
+		UserAccessLevel: 0x03,
+		Historizing:     datatypes.NewBoolean(false),
+	}
+}
+
+// ExtensionObject returns VariableAttributes in ExtensionObject, which can be used as
+// NodeAttributes in AddNodesItem.
+func (v *VariableAttributes) ExtensionObject() *datatypes.ExtensionObject {
+	e := &datatypes.ExtensionObject{
+		TypeID: datatypes.NewExpandedNodeID(
+			false, false,
+			datatypes.NewFourByteNodeID(0, id.VariableAttributes_Encoding_DefaultBinary),
+			"", 0,
+		),
+		EncodingMask: 0x01,
+		Value:        variableAttributesData{v},
+	}
+	e.SetLength()
+
+	return e
+}
+
+// variableAttributesData is VariableAttributes as datatypes.Data in ExtensionObject.
+// VariableAttributes cannot implement datatypes.Data by itself, as the DataType field
+// conflicts with the DataType method.
+type variableAttributesData struct {
+	*VariableAttributes
+}
+
+// DataType returns type of Data.
+func (v variableAttributesData) DataType() uint16 {
+	return id.Structure
+}
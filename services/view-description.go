@@ -0,0 +1,120 @@
+// Copyright 2018 gopcua authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package services
+
+import (
+	"encoding/binary"
+	"time"
+
+	"github.com/wmnsk/gopcua/datatypes"
+	"github.com/wmnsk/gopcua/errors"
+	"github.com/wmnsk/gopcua/utils"
+)
+
+// ViewDescription specifies the View in which Browse is performed.
+// The whole AddressSpace is browsed if ViewID is null, which is what
+// NewNullViewDescription creates.
+//
+// Specification: Part 4, 7.39
+type ViewDescription struct {
+	ViewID datatypes.NodeID
+
+	// The time date desired. It is null, i.e. zero time.Time, for the current View.
+	Timestamp time.Time
+
+	// The version number for the View desired. 0 for the current View.
+	ViewVersion uint32
+}
+
+// NewViewDescription creates a new ViewDescription.
+func NewViewDescription(viewID datatypes.NodeID, ts time.Time, version uint32) *ViewDescription {
+	return &ViewDescription{
+		ViewID:      viewID,
+		Timestamp:   ts,
+		ViewVersion: version,
+	}
+}
+
+// NewNullViewDescription creates a new ViewDescription to browse the whole AddressSpace.
+func NewNullViewDescription() *ViewDescription {
+	return NewViewDescription(datatypes.NewTwoByteNodeID(0), time.Time{}, 0)
+}
+
+// DecodeViewDescription decodes given bytes into ViewDescription.
+func DecodeViewDescription(b []byte) (*ViewDescription, error) {
+	v := &ViewDescription{}
+	if err := v.DecodeFromBytes(b); err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
+
+// DecodeFromBytes decodes given bytes into ViewDescription.
+func (v *ViewDescription) DecodeFromBytes(b []byte) error {
+	viewID, err := datatypes.DecodeNodeID(b)
+	if err != nil {
+		return err
+	}
+	v.ViewID = viewID
+	offset := v.ViewID.Len()
+
+	if len(b[offset:]) < 12 {
+		return errors.NewErrTooShortToDecode(v, "should have Timestamp and ViewVersion after ViewID")
+	}
+	v.Timestamp = time.Time{}
+	if binary.LittleEndian.Uint64(b[offset:offset+8]) != 0 {
+		v.Timestamp = utils.DecodeTimestamp(b[offset : offset+8])
+	}
+	offset += 8
+
+	v.ViewVersion = binary.LittleEndian.Uint32(b[offset : offset+4])
+
+	return nil
+}
+
+// Serialize serializes ViewDescription into bytes.
+func (v *ViewDescription) Serialize() ([]byte, error) {
+	b := make([]byte, v.Len())
+	if err := v.SerializeTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// SerializeTo serializes ViewDescription into bytes.
+func (v *ViewDescription) SerializeTo(b []byte) error {
+	offset := 0
+	if v.ViewID != nil {
+		if err := v.ViewID.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += v.ViewID.Len()
+	}
+
+	// null DateTime is encoded as 0, not as the UNIX epoch of zero time.Time.
+	if v.Timestamp.IsZero() {
+		binary.LittleEndian.PutUint64(b[offset:offset+8], 0)
+	} else {
+		utils.EncodeTimestamp(b[offset:offset+8], v.Timestamp)
+	}
+	offset += 8
+
+	binary.LittleEndian.PutUint32(b[offset:offset+4], v.ViewVersion)
+
+	return nil
+}
+
+// Len returns the actual length of ViewDescription in int.
+func (v *ViewDescription) Len() int {
+	// Timestamp + ViewVersion
+	l := 12
+	if v.ViewID != nil {
+		l += v.ViewID.Len()
+	}
+
+	return l
+}
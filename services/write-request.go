@@ -0,0 +1,135 @@
+// Copyright 2018 gopcua authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package services
+
+import (
+	"time"
+
+	"github.com/wmnsk/gopcua/datatypes"
+)
+
+// WriteRequest is used to write values to one or more Attributes of one or more Nodes.
+//
+// Specification: Part 4, 5.10.4.2
+type WriteRequest struct {
+	TypeID *datatypes.ExpandedNodeID
+	*RequestHeader
+	NodesToWrite *WriteValueArray
+}
+
+// NewWriteRequest creates a new WriteRequest.
+func NewWriteRequest(ts time.Time, authToken datatypes.NodeID, handle, diag, timeout uint32, auditID string, nodes ...*WriteValue) *WriteRequest {
+	return &WriteRequest{
+		TypeID: datatypes.NewExpandedNodeID(
+			false, false,
+			datatypes.NewFourByteNodeID(
+				0, ServiceTypeWriteRequest,
+			),
+			"", 0,
+		),
+		RequestHeader: NewRequestHeader(
+			authToken,
+			ts,
+			handle,
+			diag,
+			timeout,
+			auditID,
+			NewAdditionalHeader(
+				datatypes.NewExpandedNodeID(
+					false, false,
+					datatypes.NewTwoByteNodeID(0),
+					"", 0,
+				),
+				0x00,
+			),
+			nil,
+		),
+		NodesToWrite: NewWriteValueArray(nodes),
+	}
+}
+
+// DecodeWriteRequest decodes given bytes into WriteRequest.
+func DecodeWriteRequest(b []byte) (*WriteRequest, error) {
+	w := &WriteRequest{}
+	if err := w.DecodeFromBytes(b); err != nil {
+		return nil, err
+	}
+
+	return w, nil
+}
+
+// DecodeFromBytes decodes given bytes into WriteRequest.
+func (w *WriteRequest) DecodeFromBytes(b []byte) error {
+	offset := 0
+	w.TypeID = &datatypes.ExpandedNodeID{}
+	if err := w.TypeID.DecodeFromBytes(b[offset:]); err != nil {
+		return err
+	}
+	offset += w.TypeID.Len()
+
+	w.RequestHeader = &RequestHeader{}
+	if err := w.RequestHeader.DecodeFromBytes(b[offset:]); err != nil {
+		return err
+	}
+	offset += w.RequestHeader.Len() - len(w.RequestHeader.Payload)
+
+	w.NodesToWrite = &WriteValueArray{}
+	return w.NodesToWrite.DecodeFromBytes(b[offset:])
+}
+
+// Serialize serializes WriteRequest into bytes.
+func (w *WriteRequest) Serialize() ([]byte, error) {
+	b := make([]byte, w.Len())
+	if err := w.SerializeTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// SerializeTo serializes WriteRequest into bytes.
+func (w *WriteRequest) SerializeTo(b []byte) error {
+	offset := 0
+	if w.TypeID != nil {
+		if err := w.TypeID.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += w.TypeID.Len()
+	}
+
+	if w.RequestHeader != nil {
+		if err := w.RequestHeader.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += w.RequestHeader.Len() - len(w.Payload)
+	}
+
+	if w.NodesToWrite != nil {
+		return w.NodesToWrite.SerializeTo(b[offset:])
+	}
+
+	return nil
+}
+
+// Len returns the actual length of WriteRequest in int.
+func (w *WriteRequest) Len() int {
+	l := 0
+	if w.TypeID != nil {
+		l += w.TypeID.Len()
+	}
+	if w.RequestHeader != nil {
+		l += (w.RequestHeader.Len() - len(w.Payload))
+	}
+	if w.NodesToWrite != nil {
+		l += w.NodesToWrite.Len()
+	}
+
+	return l
+}
+
+// ServiceType returns type of Service in uint16.
+func (w *WriteRequest) ServiceType() uint16 {
+	return ServiceTypeWriteRequest
+}
@@ -0,0 +1,197 @@
+// Copyright 2018 gopcua authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/wmnsk/gopcua/datatypes"
+)
+
+var writeRequestCases = []struct {
+	description string
+	structured  *WriteRequest
+	serialized  []byte
+}{
+	{
+		"normal",
+		NewWriteRequest(
+			time.Date(2018, time.August, 10, 23, 0, 0, 0, time.UTC),
+			datatypes.NewTwoByteNodeID(0), 1, 0, 0, "",
+			NewWriteValue(
+				datatypes.NewFourByteNodeID(2, 1001),
+				datatypes.IntegerIDValue,
+				"",
+				datatypes.NewDataValue(
+					true, false, false, false, false, false,
+					datatypes.NewVariant(datatypes.NewFloat(2.5)),
+					0, time.Time{}, 0, time.Time{}, 0,
+				),
+			),
+		),
+		[]byte{ // WriteRequest
+			// TypeID
+			0x01, 0x00, 0xa1, 0x02,
+			// RequestHeader
+			// AuthenticationToken
+			0x00, 0x00,
+			// Timestamp
+			0x00, 0x98, 0x67, 0xdd, 0xfd, 0x30, 0xd4, 0x01,
+			// RequestHandle
+			0x01, 0x00, 0x00, 0x00,
+			// ReturnDiagnostics
+			0x00, 0x00, 0x00, 0x00,
+			// AuditEntryID
+			0xff, 0xff, 0xff, 0xff,
+			// TimeoutHint
+			0x00, 0x00, 0x00, 0x00,
+			// AdditionalHeader
+			0x00, 0x00, 0x00,
+			// NodesToWrite
+			// ArraySize
+			0x01, 0x00, 0x00, 0x00,
+			// NodeID
+			0x01, 0x02, 0xe9, 0x03,
+			// AttributeID
+			0x0d, 0x00, 0x00, 0x00,
+			// IndexRange
+			0xff, 0xff, 0xff, 0xff,
+			// Value
+			0x01, 0x0a, 0x00, 0x00, 0x20, 0x40,
+		},
+	},
+	{
+		"multiple-nodes",
+		NewWriteRequest(
+			time.Date(2018, time.August, 10, 23, 0, 0, 0, time.UTC),
+			datatypes.NewTwoByteNodeID(0), 1, 0, 0, "",
+			NewWriteValue(
+				datatypes.NewFourByteNodeID(2, 1001),
+				datatypes.IntegerIDValue,
+				"",
+				datatypes.NewDataValue(
+					true, false, true, false, false, false,
+					datatypes.NewVariant(datatypes.NewBoolean(true)),
+					0, time.Date(2018, time.August, 10, 23, 0, 0, 0, time.UTC), 0, time.Time{}, 0,
+				),
+			),
+			NewWriteValue(
+				datatypes.NewFourByteNodeID(2, 1002),
+				datatypes.IntegerIDValue,
+				"",
+				datatypes.NewDataValue(
+					true, false, false, false, false, false,
+					datatypes.NewVariant(datatypes.NewLocalizedText("", "on")),
+					0, time.Time{}, 0, time.Time{}, 0,
+				),
+			),
+		),
+		[]byte{ // WriteRequest
+			// TypeID
+			0x01, 0x00, 0xa1, 0x02,
+			// RequestHeader
+			// AuthenticationToken
+			0x00, 0x00,
+			// Timestamp
+			0x00, 0x98, 0x67, 0xdd, 0xfd, 0x30, 0xd4, 0x01,
+			// RequestHandle
+			0x01, 0x00, 0x00, 0x00,
+			// ReturnDiagnostics
+			0x00, 0x00, 0x00, 0x00,
+			// AuditEntryID
+			0xff, 0xff, 0xff, 0xff,
+			// TimeoutHint
+			0x00, 0x00, 0x00, 0x00,
+			// AdditionalHeader
+			0x00, 0x00, 0x00,
+			// NodesToWrite
+			// ArraySize
+			0x02, 0x00, 0x00, 0x00,
+			// NodeID
+			0x01, 0x02, 0xe9, 0x03,
+			// AttributeID
+			0x0d, 0x00, 0x00, 0x00,
+			// IndexRange
+			0xff, 0xff, 0xff, 0xff,
+			// Value
+			0x05, 0x01, 0x01,
+			// SourceTimestamp
+			0x00, 0x98, 0x67, 0xdd, 0xfd, 0x30, 0xd4, 0x01,
+			// NodeID
+			0x01, 0x02, 0xea, 0x03,
+			// AttributeID
+			0x0d, 0x00, 0x00, 0x00,
+			// IndexRange
+			0xff, 0xff, 0xff, 0xff,
+			// Value
+			0x01, 0x15, 0x02, 0x02, 0x00, 0x00, 0x00, 0x6f, 0x6e,
+		},
+	},
+}
+
+func TestDecodeWriteRequest(t *testing.T) {
+	for _, c := range writeRequestCases {
+		got, err := DecodeWriteRequest(c.serialized)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// need to clear Payload here.
+		got.Payload = nil
+
+		if diff := cmp.Diff(got, c.structured, decodeCmpOpt); diff != "" {
+			t.Errorf("%s failed\n%s", c.description, diff)
+		}
+	}
+}
+
+func TestSerializeWriteRequest(t *testing.T) {
+	for _, c := range writeRequestCases {
+		got, err := c.structured.Serialize()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if diff := cmp.Diff(got, c.serialized); diff != "" {
+			t.Errorf("%s failed\n%s", c.description, diff)
+		}
+	}
+}
+
+func TestWriteRequestLen(t *testing.T) {
+	for _, c := range writeRequestCases {
+		got := c.structured.Len()
+
+		if diff := cmp.Diff(got, len(c.serialized)); diff != "" {
+			t.Errorf("%s failed\n%s", c.description, diff)
+		}
+	}
+}
+
+func TestWriteRequestServiceType(t *testing.T) {
+	for _, c := range writeRequestCases {
+		if c.structured.ServiceType() != ServiceTypeWriteRequest {
+			t.Errorf(
+				"ServiceType doesn't match. Want: %d, Got: %d",
+				ServiceTypeWriteRequest,
+				c.structured.ServiceType(),
+			)
+		}
+	}
+}
+
+func TestWriteRequestInvalidIndexRange(t *testing.T) {
+	w := NewWriteRequest(
+		time.Date(2018, time.August, 10, 23, 0, 0, 0, time.UTC),
+		datatypes.NewTwoByteNodeID(0), 1, 0, 0, "",
+		NewWriteValue(datatypes.NewFourByteNodeID(2, 1001), datatypes.IntegerIDValue, "3:1", nil),
+	)
+	if _, err := w.Serialize(); err == nil {
+		t.Error("expected error")
+	}
+}
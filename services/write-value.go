@@ -0,0 +1,215 @@
+// Copyright 2018 gopcua authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package services
+
+import (
+	"encoding/binary"
+
+	"github.com/wmnsk/gopcua/datatypes"
+	"github.com/wmnsk/gopcua/errors"
+)
+
+// WriteValue represents a value to be written to an Attribute of a Node in WriteRequest.
+//
+// Specification: Part 4, 5.10.4.2
+type WriteValue struct {
+	NodeID      datatypes.NodeID
+	AttributeID datatypes.IntegerID
+	IndexRange  *datatypes.String
+
+	// The value to write. If the StatusCode or the timestamps are omitted,
+	// the Server uses its own values for them.
+	Value *datatypes.DataValue
+}
+
+// NewWriteValue creates a new WriteValue.
+func NewWriteValue(nodeID datatypes.NodeID, attrID datatypes.IntegerID, idxRange string, value *datatypes.DataValue) *WriteValue {
+	return &WriteValue{
+		NodeID:      nodeID,
+		AttributeID: attrID,
+		IndexRange:  datatypes.NewString(idxRange),
+		Value:       value,
+	}
+}
+
+// DecodeWriteValue decodes given bytes into WriteValue.
+func DecodeWriteValue(b []byte) (*WriteValue, error) {
+	w := &WriteValue{}
+	if err := w.DecodeFromBytes(b); err != nil {
+		return nil, err
+	}
+
+	return w, nil
+}
+
+// DecodeFromBytes decodes given bytes into WriteValue.
+func (w *WriteValue) DecodeFromBytes(b []byte) error {
+	nodeID, err := datatypes.DecodeNodeID(b)
+	if err != nil {
+		return err
+	}
+	w.NodeID = nodeID
+	offset := w.NodeID.Len()
+
+	w.AttributeID = datatypes.IntegerID(binary.LittleEndian.Uint32(b[offset : offset+4]))
+	offset += 4
+
+	w.IndexRange = &datatypes.String{}
+	if err := w.IndexRange.DecodeFromBytes(b[offset:]); err != nil {
+		return err
+	}
+	offset += w.IndexRange.Len()
+
+	w.Value = &datatypes.DataValue{}
+	return w.Value.DecodeFromBytes(b[offset:])
+}
+
+// Serialize serializes WriteValue into bytes.
+func (w *WriteValue) Serialize() ([]byte, error) {
+	b := make([]byte, w.Len())
+	if err := w.SerializeTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// SerializeTo serializes WriteValue into bytes.
+func (w *WriteValue) SerializeTo(b []byte) error {
+	offset := 0
+	if w.NodeID != nil {
+		if err := w.NodeID.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += w.NodeID.Len()
+	}
+
+	binary.LittleEndian.PutUint32(b[offset:offset+4], uint32(w.AttributeID))
+	offset += 4
+
+	if w.IndexRange != nil {
+		if err := datatypes.ValidateIndexRange(w.IndexRange.Get()); err != nil {
+			return err
+		}
+		if err := w.IndexRange.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += w.IndexRange.Len()
+	}
+
+	if w.Value != nil {
+		return w.Value.SerializeTo(b[offset:])
+	}
+
+	return nil
+}
+
+// Len returns the actual length of WriteValue in int.
+func (w *WriteValue) Len() int {
+	// AttributeID
+	l := 4
+	if w.NodeID != nil {
+		l += w.NodeID.Len()
+	}
+	if w.IndexRange != nil {
+		l += w.IndexRange.Len()
+	}
+	if w.Value != nil {
+		l += w.Value.Len()
+	}
+
+	return l
+}
+
+// WriteValueArray represents an array of WriteValues.
+// It does not correspond to a certain type from the specification
+// but makes encoding and decoding easier.
+type WriteValueArray struct {
+	ArraySize   int32
+	WriteValues []*WriteValue
+}
+
+// NewWriteValueArray creates a new WriteValueArray from multiple WriteValues.
+func NewWriteValueArray(writeValues []*WriteValue) *WriteValueArray {
+	if writeValues == nil {
+		return &WriteValueArray{
+			ArraySize: 0,
+		}
+	}
+
+	return &WriteValueArray{
+		ArraySize:   int32(len(writeValues)),
+		WriteValues: writeValues,
+	}
+}
+
+// DecodeWriteValueArray decodes given bytes into WriteValueArray.
+func DecodeWriteValueArray(b []byte) (*WriteValueArray, error) {
+	w := &WriteValueArray{}
+	if err := w.DecodeFromBytes(b); err != nil {
+		return nil, err
+	}
+
+	return w, nil
+}
+
+// DecodeFromBytes decodes given bytes into WriteValueArray.
+func (w *WriteValueArray) DecodeFromBytes(b []byte) error {
+	if len(b) < 4 {
+		return errors.NewErrTooShortToDecode(w, "should be longer than 4 bytes")
+	}
+
+	w.ArraySize = int32(binary.LittleEndian.Uint32(b[:4]))
+	if w.ArraySize <= 0 {
+		return nil
+	}
+
+	offset := 4
+	for i := 1; i <= int(w.ArraySize); i++ {
+		writeValue, err := DecodeWriteValue(b[offset:])
+		if err != nil {
+			return err
+		}
+		w.WriteValues = append(w.WriteValues, writeValue)
+		offset += writeValue.Len()
+	}
+
+	return nil
+}
+
+// Serialize serializes WriteValueArray into bytes.
+func (w *WriteValueArray) Serialize() ([]byte, error) {
+	b := make([]byte, w.Len())
+	if err := w.SerializeTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// SerializeTo serializes WriteValueArray into bytes.
+func (w *WriteValueArray) SerializeTo(b []byte) error {
+	offset := 4
+	binary.LittleEndian.PutUint32(b[:4], uint32(w.ArraySize))
+
+	for _, writeValue := range w.WriteValues {
+		if err := writeValue.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += writeValue.Len()
+	}
+
+	return nil
+}
+
+// Len returns the actual length of WriteValueArray in int.
+func (w *WriteValueArray) Len() int {
+	l := 4
+	for _, writeValue := range w.WriteValues {
+		l += writeValue.Len()
+	}
+
+	return l
+}
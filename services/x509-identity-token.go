@@ -0,0 +1,122 @@
+// Copyright 2018 gopcua authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package services
+
+import (
+	"github.com/wmnsk/gopcua/datatypes"
+	"github.com/wmnsk/gopcua/id"
+)
+
+func init() {
+	datatypes.RegisterExtensionObject(
+		datatypes.NewFourByteNodeID(0, id.X509IdentityToken_Encoding_DefaultBinary),
+		func() datatypes.Data { return &X509IdentityToken{} },
+	)
+}
+
+// X509IdentityToken is used to pass an X.509 v3 Certificate which is issued by the user
+// as UserIdentityToken in ActivateSessionRequest.
+//
+// The UserTokenSignature in ActivateSessionRequest should be created with the
+// private key of the Certificate.
+//
+// Specification: Part 4, 7.36.5
+type X509IdentityToken struct {
+	PolicyID        *datatypes.String
+	CertificateData *datatypes.ByteString
+}
+
+// NewX509IdentityToken creates a new X509IdentityToken.
+// cert is the DER encoded Certificate of the user.
+func NewX509IdentityToken(policyID string, cert []byte) *X509IdentityToken {
+	return &X509IdentityToken{
+		PolicyID:        datatypes.NewString(policyID),
+		CertificateData: datatypes.NewByteString(cert),
+	}
+}
+
+// DecodeX509IdentityToken decodes given bytes into X509IdentityToken.
+func DecodeX509IdentityToken(b []byte) (*X509IdentityToken, error) {
+	x := &X509IdentityToken{}
+	if err := x.DecodeFromBytes(b); err != nil {
+		return nil, err
+	}
+
+	return x, nil
+}
+
+// DecodeFromBytes decodes given bytes into X509IdentityToken.
+func (x *X509IdentityToken) DecodeFromBytes(b []byte) error {
+	x.PolicyID = &datatypes.String{}
+	if err := x.PolicyID.DecodeFromBytes(b); err != nil {
+		return err
+	}
+	offset := x.PolicyID.Len()
+
+	x.CertificateData = &datatypes.ByteString{}
+	return x.CertificateData.DecodeFromBytes(b[offset:])
+}
+
+// Serialize serializes X509IdentityToken into bytes.
+func (x *X509IdentityToken) Serialize() ([]byte, error) {
+	b := make([]byte, x.Len())
+	if err := x.SerializeTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// SerializeTo serializes X509IdentityToken into bytes.
+func (x *X509IdentityToken) SerializeTo(b []byte) error {
+	offset := 0
+	if x.PolicyID != nil {
+		if err := x.PolicyID.SerializeTo(b[offset:]); err != nil {
+			return err
+		}
+		offset += x.PolicyID.Len()
+	}
+
+	if x.CertificateData != nil {
+		return x.CertificateData.SerializeTo(b[offset:])
+	}
+
+	return nil
+}
+
+// Len returns the actual length of X509IdentityToken in int.
+func (x *X509IdentityToken) Len() int {
+	l := 0
+	if x.PolicyID != nil {
+		l += x.PolicyID.Len()
+	}
+	if x.CertificateData != nil {
+		l += x.CertificateData.Len()
+	}
+
+	return l
+}
+
+// DataType returns type of Data.
+func (x *X509IdentityToken) DataType() uint16 {
+	return id.Structure
+}
+
+// ExtensionObject returns X509IdentityToken in ExtensionObject, which can be used as
+// UserIdentityToken in ActivateSessionRequest.
+func (x *X509IdentityToken) ExtensionObject() *datatypes.ExtensionObject {
+	e := &datatypes.ExtensionObject{
+		TypeID: datatypes.NewExpandedNodeID(
+			false, false,
+			datatypes.NewFourByteNodeID(0, id.X509IdentityToken_Encoding_DefaultBinary),
+			"", 0,
+		),
+		EncodingMask: 0x01,
+		Value:        x,
+	}
+	e.SetLength()
+
+	return e
+}
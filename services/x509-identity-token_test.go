@@ -0,0 +1,47 @@
+// Copyright 2018 gopcua authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package services
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/wmnsk/gopcua/datatypes"
+)
+
+var x509IdentityTokenBytes = []byte{
+	// TypeID
+	0x01, 0x00, 0x47, 0x01,
+	// EncodingMask
+	0x01,
+	// Length
+	0x0e, 0x00, 0x00, 0x00,
+	// PolicyID
+	0x04, 0x00, 0x00, 0x00, 0x78, 0x35, 0x30, 0x39,
+	// CertificateData
+	0x02, 0x00, 0x00, 0x00, 0xde, 0xad,
+}
+
+func TestX509IdentityToken(t *testing.T) {
+	t.Run("serialize", func(t *testing.T) {
+		b, err := NewX509IdentityToken("x509", []byte{0xde, 0xad}).ExtensionObject().Serialize()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(b, x509IdentityTokenBytes); diff != "" {
+			t.Error(diff)
+		}
+	})
+	t.Run("decode", func(t *testing.T) {
+		e, err := datatypes.DecodeExtensionObject(x509IdentityTokenBytes)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(e.Value, NewX509IdentityToken("x509", []byte{0xde, 0xad})); diff != "" {
+			t.Error(diff)
+		}
+	})
+}
@@ -9,6 +9,7 @@ import (
 	"net"
 	"time"
 
+	"github.com/wmnsk/gopcua/errors"
 	"github.com/wmnsk/gopcua/utils"
 )
 
@@ -20,59 +21,181 @@ import (
 // incoming messages automatically in another goroutine.
 //
 // If port is missing, ":4840" is automatically chosen.
+// The address to connect to can be overridden with WithAddress.
 // If laddr is nil, a local address is automatically chosen.
-func Dial(ctx context.Context, endpoint string) (*Conn, error) {
-	return dial(ctx, endpoint, 5*time.Second, 3)
+//
+// The underlying connection and the parameters sent in Hello can be
+// configured with DialOptions.
+func Dial(ctx context.Context, endpoint string, opts ...DialOption) (*Conn, error) {
+	return dial(ctx, endpoint, 5*time.Second, 3, opts...)
 }
 
 // DialTimeout is Dial with retransmission interval and max retransmission count.
-func DialTimeout(ctx context.Context, endpoint string, interval time.Duration, maxRetry int) (*Conn, error) {
-	return dial(ctx, endpoint, interval, maxRetry)
+func DialTimeout(ctx context.Context, endpoint string, interval time.Duration, maxRetry int, opts ...DialOption) (*Conn, error) {
+	return dial(ctx, endpoint, interval, maxRetry, opts...)
+}
+
+// Dialer is the interface used to establish the underlying connection.
+//
+// *net.Dialer satisfies this interface, as well as any other dialer
+// (e.g. a SOCKS proxy dialer) which implements DialContext.
+type Dialer interface {
+	DialContext(ctx context.Context, network, address string) (net.Conn, error)
+}
+
+type dialConfig struct {
+	dialer      Dialer
+	addr        string
+	rcvBufSize  uint32
+	sndBufSize  uint32
+	maxMsgSize  uint32
+	maxChunkCnt uint32
+
+	// err is set by the DialOption given an invalid value, and returned when dialing.
+	err error
+}
+
+// DialOption configures how Dial establishes the connection.
+type DialOption func(*dialConfig)
+
+// WithDialer sets the Dialer used to establish the underlying connection.
+// If not given, a zero value of net.Dialer is used.
+func WithDialer(d Dialer) DialOption {
+	return func(c *dialConfig) {
+		c.dialer = d
+	}
 }
 
-func dial(ctx context.Context, endpoint string, interval time.Duration, maxRetry int) (*Conn, error) {
-	network, raddr, err := utils.ResolveEndpoint(endpoint)
+// WithAddress sets the address to connect to, in "host[:port]" format, instead of
+// the one in the endpoint. If port is missing, ":4840" is automatically chosen.
+//
+// This is useful when the Server advertises the EndpointURL with a host name that
+// cannot be reached from the client, e.g. behind NAT or in a container. The endpoint
+// given to Dial is still sent in Hello as it is, which is the one the Server expects.
+func WithAddress(addr string) DialOption {
+	return func(c *dialConfig) {
+		c.addr = addr
+	}
+}
+
+// WithBufferSizes sets the ReceiveBufferSize, SendBufferSize, MaxMessageSize and
+// MaxChunkCount sent in Hello message.
+//
+// rcvBuf and sndBuf should be at least 8192 bytes, otherwise dialing fails.
+// 0 for maxMsg or maxChunk means no limit.
+func WithBufferSizes(rcvBuf, sndBuf, maxMsg, maxChunk uint32) DialOption {
+	return func(c *dialConfig) {
+		if rcvBuf < minBufferSize || sndBuf < minBufferSize {
+			c.err = errors.Errorf("WithBufferSizes: rcvBuf and sndBuf should be at least %d bytes, got %d and %d", minBufferSize, rcvBuf, sndBuf)
+			return
+		}
+		c.rcvBufSize = rcvBuf
+		c.sndBufSize = sndBuf
+		c.maxMsgSize = maxMsg
+		c.maxChunkCnt = maxChunk
+	}
+}
+
+func dial(ctx context.Context, endpoint string, interval time.Duration, maxRetry int, opts ...DialOption) (*Conn, error) {
+	cfg, err := newDialConfig(opts...)
 	if err != nil {
 		return nil, err
 	}
-
-	conn := &Conn{
-		state:     cliStateClosed,
-		stateChan: make(chan state),
-		lenChan:   make(chan int),
-		errChan:   make(chan error),
-		rcvBuf:    make([]byte, 0xffff),
-		rep:       endpoint,
+	network, raddr, err := cfg.resolve(endpoint)
+	if err != nil {
+		return nil, err
 	}
-	conn.lowerConn, err = net.Dial(network, raddr.String())
+
+	conn := newClientConn(cfg, endpoint)
+	conn.lowerConn, err = cfg.dialer.DialContext(ctx, network, raddr)
 	if err != nil {
 		return nil, err
 	}
 
-	if err := conn.Hello(); err != nil {
+	if err := conn.open(ctx, interval, maxRetry); err != nil {
 		return nil, err
 	}
+	return conn, nil
+}
+
+// minBufferSize is the minimum ReceiveBufferSize and SendBufferSize
+// defined in the specification.
+const minBufferSize = 8192
+
+func newDialConfig(opts ...DialOption) (*dialConfig, error) {
+	cfg := &dialConfig{
+		dialer:     &net.Dialer{},
+		rcvBufSize: 0xffff,
+		sndBufSize: 0xffff,
+	}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+	if cfg.err != nil {
+		return nil, cfg.err
+	}
+	return cfg, nil
+}
+
+// resolve returns the network and the address to connect to.
+//
+// The host in endpoint is not resolved if the address is given with WithAddress,
+// as it might not be resolvable from the client.
+func (c *dialConfig) resolve(endpoint string) (network, addr string, err error) {
+	if c.addr == "" {
+		network, raddr, err := utils.ResolveEndpoint(endpoint)
+		if err != nil {
+			return "", "", err
+		}
+		return network, raddr.String(), nil
+	}
+
+	if _, _, err := net.SplitHostPort(c.addr); err != nil {
+		return "tcp", net.JoinHostPort(c.addr, "4840"), nil
+	}
+	return "tcp", c.addr, nil
+}
+
+func newClientConn(cfg *dialConfig, endpoint string) *Conn {
+	return &Conn{
+		state:         cliStateClosed,
+		stateChan:     make(chan state),
+		lenChan:       make(chan int),
+		errChan:       make(chan error),
+		rcvBuf:        make([]byte, cfg.rcvBufSize),
+		sndBuf:        make([]byte, cfg.sndBufSize),
+		maxMsgSize:    cfg.maxMsgSize,
+		maxChunkCount: cfg.maxChunkCnt,
+		rep:           endpoint,
+	}
+}
+
+// open sends Hello on the underlying connection and waits for Acknowledge.
+func (c *Conn) open(ctx context.Context, interval time.Duration, maxRetry int) error {
+	if err := c.Hello(); err != nil {
+		return err
+	}
 	sent := 1
 
-	go conn.monitorMessages(ctx)
+	go c.monitorMessages(ctx)
 	for {
 		if sent > maxRetry {
-			return nil, ErrTimeout
+			return ErrTimeout
 		}
 
 		select {
-		case s := <-conn.stateChan:
+		case s := <-c.stateChan:
 			switch s {
 			case cliStateEstablished:
-				return conn, nil
+				return nil
 			default:
 				continue
 			}
-		case err := <-conn.errChan:
-			return nil, err
+		case err := <-c.errChan:
+			return err
 		case <-time.After(interval):
-			if err := conn.Hello(); err != nil {
-				return nil, err
+			if err := c.Hello(); err != nil {
+				return err
 			}
 			sent++
 		}
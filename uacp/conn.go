@@ -19,13 +19,42 @@ import (
 type Conn struct {
 	lowerConn      net.Conn
 	lep, rep       string
+	serverURI      string
 	rcvBuf, sndBuf []byte
+	maxMsgSize     uint32
+	maxChunkCount  uint32
+	limits         Limits
 	state          state
 	stateChan      chan state
 	lenChan        chan int
 	errChan        chan error
 }
 
+// Limits is the set of buffer sizes and limits of the peer, negotiated with
+// Hello and Acknowledge.
+//
+// 0 for MaxMessageSize or MaxChunkCount means no limit.
+//
+// Specification: Part6, 7.1.2
+type Limits struct {
+	ReceiveBufSize uint32
+	SendBufSize    uint32
+	MaxMessageSize uint32
+	MaxChunkCount  uint32
+}
+
+// check returns ErrMessageTooLarge if a message of n bytes cannot be sent to the peer.
+// It is sent as a single chunk, so MaxChunkCount is always satisfied.
+func (l Limits) check(n int) error {
+	if l.ReceiveBufSize > 0 && uint32(n) > l.ReceiveBufSize {
+		return errors.Wrapf(ErrMessageTooLarge, "%d bytes exceeds ReceiveBufferSize %d of the peer", n, l.ReceiveBufSize)
+	}
+	if l.MaxMessageSize > 0 && uint32(n) > l.MaxMessageSize {
+		return errors.Wrapf(ErrMessageTooLarge, "%d bytes exceeds MaxMessageSize %d of the peer", n, l.MaxMessageSize)
+	}
+	return nil
+}
+
 // Read reads data from the connection.
 // Read can be made to time out and return an Error with Timeout() == true
 // after a fixed time limit; see SetDeadline and SetReadDeadline.
@@ -50,10 +79,16 @@ func (c *Conn) Read(b []byte) (n int, err error) {
 // Write writes data to the connection.
 // Write can be made to time out and return an Error with Timeout() == true
 // after a fixed time limit; see SetDeadline and SetWriteDeadline.
+//
+// Write returns ErrMessageTooLarge without sending anything if b exceeds
+// the Limits of the peer.
 func (c *Conn) Write(b []byte) (n int, err error) {
 	if !(c.state == cliStateEstablished || c.state == srvStateEstablished) {
 		return 0, ErrConnNotEstablished
 	}
+	if err := c.limits.check(len(b)); err != nil {
+		return 0, err
+	}
 	select {
 	case e := <-c.errChan:
 		return 0, e
@@ -103,6 +138,18 @@ func (c *Conn) RemoteEndpoint() string {
 	return c.rep
 }
 
+// Limits returns the Limits of the peer negotiated with Hello and Acknowledge.
+// It is the values in Acknowledge on client side, and the ones in Hello on server side.
+func (c *Conn) Limits() Limits {
+	return c.limits
+}
+
+// ServerURI returns the ServerURI sent in ReverseHello.
+// It is "" unless Conn is accepted by ReverseListener.
+func (c *Conn) ServerURI() string {
+	return c.serverURI
+}
+
 // SetDeadline sets the read and write deadlines associated
 // with the connection. It is equivalent to calling both
 // SetReadDeadline and SetWriteDeadline.
@@ -140,7 +187,10 @@ func (c *Conn) SetWriteDeadline(t time.Time) error {
 
 // Hello sends UACP Hello message to Conn.
 func (c *Conn) Hello() error {
-	hel, err := NewHello(0, uint32(len(c.rcvBuf)), uint32(len(c.sndBuf)), 0, c.rep).Serialize()
+	h := NewHello(0, uint32(len(c.rcvBuf)), uint32(len(c.sndBuf)), c.maxMsgSize, c.rep)
+	h.MaxChunkCount = c.maxChunkCount
+
+	hel, err := h.Serialize()
 	if err != nil {
 		return err
 	}
@@ -286,6 +336,7 @@ func (c *Conn) handleMsgHello(h *Hello) {
 		}
 
 		c.sndBuf = make([]byte, h.ReceiveBufSize)
+		c.limits = newLimitsFromHello(h)
 		if err := c.Acknowledge(); err != nil {
 			c.errChan <- err
 		}
@@ -304,11 +355,26 @@ func (c *Conn) handleMsgHello(h *Hello) {
 	}
 }
 
+func newLimitsFromHello(h *Hello) Limits {
+	return Limits{
+		ReceiveBufSize: h.ReceiveBufSize,
+		SendBufSize:    h.SendBufSize,
+		MaxMessageSize: h.MaxMessageSize,
+		MaxChunkCount:  h.MaxChunkCount,
+	}
+}
+
 func (c *Conn) handleMsgAcknowledge(a *Acknowledge) {
 	switch c.state {
 	// client accepts Acknowledge only after sending Hello.
 	case cliStateHelloSent:
 		c.rcvBuf = make([]byte, a.ReceiveBufSize)
+		c.limits = Limits{
+			ReceiveBufSize: a.ReceiveBufSize,
+			SendBufSize:    a.SendBufSize,
+			MaxMessageSize: a.MaxMessageSize,
+			MaxChunkCount:  a.MaxChunkCount,
+		}
 		c.updateState(cliStateEstablished)
 	// if client conn is closed or established, just ignore Acknowledge.
 	case cliStateClosed, cliStateEstablished:
@@ -393,4 +459,5 @@ var (
 	ErrTimeout            = errors.New("timed out")
 	ErrReceivedError      = errors.New("received Error message")
 	ErrConnNotEstablished = errors.New("connection not established")
+	ErrMessageTooLarge    = errors.New("message too large")
 )
@@ -6,9 +6,14 @@ package uacp
 
 import (
 	"context"
+	"io"
+	"net"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
+
+	"github.com/wmnsk/gopcua/errors"
 )
 
 func TestConn(t *testing.T) {
@@ -34,6 +39,246 @@ func TestConn(t *testing.T) {
 	}
 }
 
+type countingDialer struct {
+	net.Dialer
+	called int
+}
+
+func (d *countingDialer) DialContext(ctx context.Context, network, address string) (net.Conn, error) {
+	d.called++
+	return d.Dialer.DialContext(ctx, network, address)
+}
+
+func TestDialWithOptions(t *testing.T) {
+	ep := "opc.tcp://127.0.0.1:4840/foo/bar"
+	ln, err := Listen(ep, 0xffff)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+
+	ctx := context.Background()
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go func() {
+		defer ln.Close()
+		if _, err := ln.Accept(ctx); err != nil {
+			t.Error(err)
+			return
+		}
+	}()
+
+	d := &countingDialer{}
+	conn, err := Dial(ctx, ep, WithDialer(d), WithBufferSizes(0x8000, 0x4000, 0x100000, 16))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if d.called != 1 {
+		t.Errorf("dialer called %d times, want 1", d.called)
+	}
+	if got, want := len(conn.sndBuf), 0x4000; got != want {
+		t.Errorf("sndBuf size: got %d, want %d", got, want)
+	}
+	if got, want := conn.maxMsgSize, uint32(0x100000); got != want {
+		t.Errorf("maxMsgSize: got %d, want %d", got, want)
+	}
+	if got, want := conn.maxChunkCount, uint32(16); got != want {
+		t.Errorf("maxChunkCount: got %d, want %d", got, want)
+	}
+}
+
+func TestDialWithInvalidBufferSizes(t *testing.T) {
+	var cases = []struct {
+		description    string
+		rcvBuf, sndBuf uint32
+	}{
+		{"zero-rcvbuf", 0, 0x4000},
+		{"small-sndbuf", 0x8000, 8191},
+	}
+	for _, c := range cases {
+		t.Run(c.description, func(t *testing.T) {
+			d := &countingDialer{}
+			if _, err := Dial(context.Background(), "opc.tcp://127.0.0.1:4840/foo/bar", WithDialer(d), WithBufferSizes(c.rcvBuf, c.sndBuf, 0, 0)); err == nil {
+				t.Error("expected error")
+			}
+			if d.called != 0 {
+				t.Errorf("dialer called %d times, want 0", d.called)
+			}
+		})
+	}
+}
+
+func TestDialWithAddress(t *testing.T) {
+	ln, err := Listen("opc.tcp://127.0.0.1:4840/foo/bar", 0xffff)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+
+	ctx := context.Background()
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go func() {
+		defer ln.Close()
+		if _, err := ln.Accept(ctx); err != nil {
+			t.Error(err)
+			return
+		}
+	}()
+
+	// the host advertised by the server cannot be resolved.
+	ep := "opc.tcp://plc.internal.invalid:4840/foo/bar"
+	d := &countingDialer{}
+	conn, err := Dial(ctx, ep, WithDialer(d), WithAddress("127.0.0.1"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if d.called != 1 {
+		t.Errorf("dialer called %d times, want 1", d.called)
+	}
+	if got, want := conn.RemoteAddr().String(), "127.0.0.1:4840"; got != want {
+		t.Errorf("RemoteAddr: got %s, want %s", got, want)
+	}
+	if got := conn.RemoteEndpoint(); got != ep {
+		t.Errorf("RemoteEndpoint: got %s, want %s", got, ep)
+	}
+}
+
+func TestReverseListener(t *testing.T) {
+	ln, err := ListenReverse("opc.tcp://127.0.0.1:4841")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+
+	ctx := context.Background()
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// server side of reverse connect.
+	ep := "opc.tcp://127.0.0.1:4840/foo/bar"
+	go func() {
+		srvConn, err := net.Dial("tcp", ln.Addr().String())
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		defer srvConn.Close()
+
+		rhe, err := NewReverseHello("urn:gopcua:server", ep).Serialize()
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if _, err := srvConn.Write(rhe); err != nil {
+			t.Error(err)
+			return
+		}
+
+		buf := make([]byte, 1024)
+		n, err := srvConn.Read(buf)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		hel, err := DecodeHello(buf[:n])
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if got := hel.EndPointURL.Get(); got != ep {
+			t.Errorf("EndpointURL in Hello: got %s, want %s", got, ep)
+		}
+
+		ack, err := NewAcknowledge(0, 0xffff, 0xffff, 0).Serialize()
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if _, err := srvConn.Write(ack); err != nil {
+			t.Error(err)
+			return
+		}
+		<-ctx.Done()
+	}()
+
+	conn, err := ln.Accept(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := conn.RemoteEndpoint(); got != ep {
+		t.Errorf("RemoteEndpoint: got %s, want %s", got, ep)
+	}
+	if got := conn.ServerURI(); got != "urn:gopcua:server" {
+		t.Errorf("ServerURI: got %s, want %s", got, "urn:gopcua:server")
+	}
+}
+
+func TestReverseListenerHandshakeFailure(t *testing.T) {
+	ln, err := ListenReverse("opc.tcp://127.0.0.1:4841")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+
+	ctx := context.Background()
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// server side of reverse connect, which answers Hello with Error instead of Acknowledge.
+	closed := make(chan error, 1)
+	go func() {
+		srvConn, err := net.Dial("tcp", ln.Addr().String())
+		if err != nil {
+			closed <- err
+			return
+		}
+		defer srvConn.Close()
+
+		rhe, err := NewReverseHello("urn:gopcua:server", "opc.tcp://127.0.0.1:4840/foo/bar").Serialize()
+		if err != nil {
+			closed <- err
+			return
+		}
+		if _, err := srvConn.Write(rhe); err != nil {
+			closed <- err
+			return
+		}
+
+		buf := make([]byte, 1024)
+		if _, err := srvConn.Read(buf); err != nil {
+			closed <- err
+			return
+		}
+		e, err := NewError(BadTCPInternalError, "").Serialize()
+		if err != nil {
+			closed <- err
+			return
+		}
+		if _, err := srvConn.Write(e); err != nil {
+			closed <- err
+			return
+		}
+
+		// the client should close the connection.
+		srvConn.SetReadDeadline(time.Now().Add(5 * time.Second))
+		if _, err := srvConn.Read(buf); err != io.EOF {
+			closed <- errors.Errorf("expected EOF, got %v", err)
+			return
+		}
+		closed <- nil
+	}()
+
+	if _, err := ln.Accept(ctx); err == nil {
+		t.Error("expected error")
+	}
+	if err := <-closed; err != nil {
+		t.Error(err)
+	}
+}
+
 func TestClientWrite(t *testing.T) {
 	ep := "opc.tcp://127.0.0.1:4840/foo/bar"
 	ln, err := Listen(ep, 0xffff)
@@ -166,3 +411,49 @@ func TestConnGetState(t *testing.T) {
 		}
 	}
 }
+
+func TestConnLimits(t *testing.T) {
+	ep := "opc.tcp://127.0.0.1:4840/foo/bar"
+	ln, err := Listen(ep, 0x2000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+
+	ctx := context.Background()
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	srvChan := make(chan *Conn, 1)
+	go func() {
+		defer ln.Close()
+		srvConn, err := ln.Accept(ctx)
+		if err != nil {
+			t.Error(err)
+		}
+		srvChan <- srvConn
+	}()
+
+	cliConn, err := Dial(ctx, ep, WithBufferSizes(0xffff, 0xffff, 0x100000, 16))
+	if err != nil {
+		t.Fatal(err)
+	}
+	srvConn := <-srvChan
+	if srvConn == nil {
+		t.FailNow()
+	}
+
+	if diff := cmp.Diff(cliConn.Limits(), Limits{ReceiveBufSize: 0x2000, SendBufSize: 0xffff}); diff != "" {
+		t.Errorf("client side: %s", diff)
+	}
+	if diff := cmp.Diff(srvConn.Limits(), Limits{ReceiveBufSize: 0xffff, SendBufSize: 0xffff, MaxMessageSize: 0x100000, MaxChunkCount: 16}); diff != "" {
+		t.Errorf("server side: %s", diff)
+	}
+
+	if _, err := cliConn.Write(make([]byte, 0x2001)); errors.Cause(err) != ErrMessageTooLarge {
+		t.Errorf("expected %v, got %v", ErrMessageTooLarge, err)
+	}
+	if _, err := cliConn.Write(make([]byte, 0x2000)); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,104 @@
+// Copyright 2018 gopcua authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package uacp
+
+import (
+	"context"
+	"net"
+	"time"
+
+	"github.com/wmnsk/gopcua/utils"
+)
+
+// ReverseListener is a OPC UA Connection Protocol network listener for the client
+// side of reverse connect, in which the server initiates the connection and
+// sends ReverseHello to the client.
+//
+// Specification: Part6, 7.1.3
+type ReverseListener struct {
+	lowerListener net.Listener
+	cfg           *dialConfig
+}
+
+// ListenReverse acts like net.Listen for the client side of reverse connect.
+//
+// Currently the endpoint can only be specified in "opc.tcp://<addr[:port]>" format.
+// The DialOptions other than WithDialer are applied to the connections accepted.
+func ListenReverse(endpoint string, opts ...DialOption) (*ReverseListener, error) {
+	network, laddr, err := utils.ResolveEndpoint(endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg, err := newDialConfig(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	lis := &ReverseListener{
+		cfg: cfg,
+	}
+	lis.lowerListener, err = net.Listen(network, laddr.String())
+	if err != nil {
+		return nil, err
+	}
+
+	return lis, nil
+}
+
+// Accept waits for the server to connect and send ReverseHello, and then opens the
+// connection to the EndpointURL in ReverseHello by sending Hello as Dial does.
+//
+// The first param ctx is to be passed to monitorMessages(), which monitors and handles
+// incoming messages automatically in another goroutine.
+func (l *ReverseListener) Accept(ctx context.Context) (*Conn, error) {
+	lowerConn, err := l.lowerListener.Accept()
+	if err != nil {
+		return nil, err
+	}
+
+	conn := newClientConn(l.cfg, "")
+	conn.lowerConn = lowerConn
+
+	n, err := lowerConn.Read(conn.rcvBuf)
+	if err != nil {
+		lowerConn.Close()
+		return nil, err
+	}
+
+	message, err := Decode(conn.rcvBuf[:n])
+	if err != nil {
+		lowerConn.Close()
+		return nil, err
+	}
+
+	r, ok := message.(*ReverseHello)
+	if !ok {
+		if err := conn.Error(BadTCPMessageTypeInvalid, "Expected ReverseHello"); err != nil {
+			lowerConn.Close()
+			return nil, err
+		}
+		lowerConn.Close()
+		return nil, ErrUnexpectedMessage
+	}
+	conn.rep = r.EndPointURL.Get()
+	conn.serverURI = r.ServerURI.Get()
+
+	if err := conn.open(ctx, 5*time.Second, 3); err != nil {
+		lowerConn.Close()
+		return nil, err
+	}
+	return conn, nil
+}
+
+// Close closes the ReverseListener.
+func (l *ReverseListener) Close() error {
+	return l.lowerListener.Close()
+}
+
+// Addr returns the listener's network address.
+func (l *ReverseListener) Addr() net.Addr {
+	return l.lowerListener.Addr()
+}
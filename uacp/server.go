@@ -86,6 +86,7 @@ func (l *Listener) Accept(ctx context.Context) (*Conn, error) {
 		}
 
 		conn.sndBuf = make([]byte, msg.ReceiveBufSize)
+		conn.limits = newLimitsFromHello(msg)
 		if err := conn.Acknowledge(); err != nil {
 			return nil, err
 		}
@@ -0,0 +1,210 @@
+// Copyright 2018 gopcua authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package uasc
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/wmnsk/gopcua/errors"
+	"github.com/wmnsk/gopcua/services"
+)
+
+// CertificateValidator validates the certificate of the peer.
+// It should return non-nil error to reject the certificate.
+//
+// Any function with this signature can be used, e.g. a callback that pins
+// the certificate seen on the first connection and rejects any other one.
+type CertificateValidator func(cert *x509.Certificate) error
+
+// NewTrustListValidator creates a CertificateValidator which accepts only the
+// certificates that are not expired and chain up to one of the certificates in
+// the trust list directory dir.
+//
+// Each file in dir is expected to contain one or more PEM encoded certificates,
+// or a single DER encoded certificate. Hidden files and the files which contain
+// neither of them, e.g. README or CRLs, are skipped.
+func NewTrustListValidator(dir string) (CertificateValidator, error) {
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	pool := x509.NewCertPool()
+	for _, f := range files {
+		if f.IsDir() || strings.HasPrefix(f.Name(), ".") {
+			continue
+		}
+		b, err := ioutil.ReadFile(filepath.Join(dir, f.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		if pool.AppendCertsFromPEM(b) {
+			continue
+		}
+		cert, err := x509.ParseCertificate(b)
+		if err != nil {
+			continue
+		}
+		pool.AddCert(cert)
+	}
+
+	return func(cert *x509.Certificate) error {
+		now := time.Now()
+		if now.Before(cert.NotBefore) || now.After(cert.NotAfter) {
+			return errors.Errorf("certificate of %s is not valid at %s", cert.Subject, now.Format(time.RFC3339))
+		}
+
+		if _, err := cert.Verify(x509.VerifyOptions{
+			Roots:       pool,
+			CurrentTime: now,
+			KeyUsages:   []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+		}); err != nil {
+			return errors.Wrapf(err, "certificate of %s is not trusted", cert.Subject)
+		}
+		return nil
+	}, nil
+}
+
+// GenerateCertificate creates a self-signed Application Instance Certificate and
+// its RSA private key, both in PEM format.
+//
+// appURI is set as the URI in SubjectAltName, which should be the same as the
+// ApplicationURI in ApplicationDescription. The host name of the machine is set as
+// DNSName as well. The certificate can be used both as a client and as a server.
+// validFor should be positive, otherwise an error is returned.
+//
+// Specification: Part 6, 6.2.2
+func GenerateCertificate(appURI, commonName string, validFor time.Duration) (certPEM, keyPEM []byte, err error) {
+	if validFor <= 0 {
+		return nil, nil, errors.Errorf("validFor should be positive, got %s", validFor)
+	}
+
+	uri, err := url.Parse(appURI)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "invalid ApplicationURI")
+	}
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	notBefore := time.Now()
+	tmpl := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    notBefore,
+		NotAfter:     notBefore.Add(validFor),
+		KeyUsage: x509.KeyUsageDigitalSignature | x509.KeyUsageContentCommitment |
+			x509.KeyUsageKeyEncipherment | x509.KeyUsageDataEncipherment | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		URIs:                  []*url.URL{uri},
+	}
+	if host, err := os.Hostname(); err == nil {
+		if ip := net.ParseIP(host); ip != nil {
+			tmpl.IPAddresses = append(tmpl.IPAddresses, ip)
+		} else {
+			tmpl.DNSNames = append(tmpl.DNSNames, host)
+		}
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to create certificate")
+	}
+
+	certPEM = pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM = pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+	return certPEM, keyPEM, nil
+}
+
+// validateCertificate parses the DER encoded certificate and runs validator with it.
+// It returns nil if there is nothing to validate, i.e. validator or the certificate is absent.
+func validateCertificate(validator CertificateValidator, der []byte) error {
+	if validator == nil || len(der) == 0 {
+		return nil
+	}
+
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		return errors.Wrap(err, "failed to parse certificate")
+	}
+	if err := validator(cert); err != nil {
+		return errors.Wrap(err, "certificate rejected")
+	}
+	return nil
+}
+
+// Asymmetric signature algorithms used in SignatureData.
+//
+// Specification: Part 7, 6.6
+const (
+	SignatureAlgorithmRSASHA1      = "http://www.w3.org/2000/09/xmldsig#rsa-sha1"
+	SignatureAlgorithmRSASHA256    = "http://www.w3.org/2001/04/xmldsig-more#rsa-sha256"
+	SignatureAlgorithmRSAPSSSHA256 = "http://opcfoundation.org/UA/security/rsa-pss-sha2-256"
+)
+
+// pssOptions is used for RSA-PSS signatures, which require the salt length
+// to be equal to the hash length.
+var pssOptions = &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthEqualsHash, Hash: crypto.SHA256}
+
+// NewUserTokenSignature creates the UserTokenSignature for X509IdentityToken in
+// ActivateSessionRequest, by signing the server certificate followed by the last
+// server nonce with the private key of the user certificate.
+//
+// policyURI is the SecurityPolicyURI of the UserTokenPolicy, or that of the endpoint
+// if the UserTokenPolicy does not specify one. The signature algorithm is chosen
+// to be the one the SecurityPolicy defines.
+func NewUserTokenSignature(policyURI string, key *rsa.PrivateKey, serverCert, serverNonce []byte) (*services.SignatureData, error) {
+	data := append(append([]byte{}, serverCert...), serverNonce...)
+
+	switch policyURI {
+	case "http://opcfoundation.org/UA/SecurityPolicy#Basic128Rsa15", "http://opcfoundation.org/UA/SecurityPolicy#Basic256":
+		h := sha1.Sum(data)
+		sig, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA1, h[:])
+		if err != nil {
+			return nil, err
+		}
+		return services.NewSignatureData(SignatureAlgorithmRSASHA1, sig), nil
+	case "http://opcfoundation.org/UA/SecurityPolicy#Basic256Sha256", "http://opcfoundation.org/UA/SecurityPolicy#Aes128_Sha256_RsaOaep":
+		h := sha256.Sum256(data)
+		sig, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, h[:])
+		if err != nil {
+			return nil, err
+		}
+		return services.NewSignatureData(SignatureAlgorithmRSASHA256, sig), nil
+	case "http://opcfoundation.org/UA/SecurityPolicy#Aes256_Sha256_RsaPss":
+		h := sha256.Sum256(data)
+		sig, err := rsa.SignPSS(rand.Reader, key, crypto.SHA256, h[:], pssOptions)
+		if err != nil {
+			return nil, err
+		}
+		return services.NewSignatureData(SignatureAlgorithmRSAPSSSHA256, sig), nil
+	default:
+		return nil, errors.NewErrUnsupported(policyURI, "cannot sign UserIdentityToken with this SecurityPolicy.")
+	}
+}
@@ -0,0 +1,220 @@
+// Copyright 2018 gopcua authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package uasc
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func newTestCertificate(t *testing.T, name string, notBefore, notAfter time.Time) *x509.Certificate {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             notBefore,
+		NotAfter:              notAfter,
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cert
+}
+
+func TestTrustListValidator(t *testing.T) {
+	now := time.Now()
+	trusted := newTestCertificate(t, "trusted", now.Add(-time.Hour), now.Add(time.Hour))
+	untrusted := newTestCertificate(t, "untrusted", now.Add(-time.Hour), now.Add(time.Hour))
+	expired := newTestCertificate(t, "expired", now.Add(-2*time.Hour), now.Add(-time.Hour))
+
+	dir, err := ioutil.TempDir("", "gopcua-trust")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	// one in PEM and the other in DER.
+	p := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: trusted.Raw})
+	if err := ioutil.WriteFile(filepath.Join(dir, "trusted.pem"), p, 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "expired.der"), expired.Raw, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	// the files which are not certificates should be skipped.
+	others := map[string][]byte{
+		"README":        []byte("trusted certificates of the servers\n"),
+		"revoked.crl":   {0xde, 0xad, 0xbe, 0xef},
+		".trusted.pem~": p[:len(p)/2],
+	}
+	for name, b := range others {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), b, 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	validate, err := NewTrustListValidator(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var cases = []struct {
+		description string
+		cert        *x509.Certificate
+		valid       bool
+	}{
+		{"trusted", trusted, true},
+		{"untrusted", untrusted, false},
+		{"expired", expired, false},
+	}
+	for _, c := range cases {
+		t.Run(c.description, func(t *testing.T) {
+			err := validateCertificate(validate, c.cert.Raw)
+			if c.valid && err != nil {
+				t.Errorf("expected to be accepted, got %v", err)
+			}
+			if !c.valid && err == nil {
+				t.Error("expected to be rejected")
+			}
+		})
+	}
+
+	t.Run("no-validator", func(t *testing.T) {
+		if err := validateCertificate(nil, untrusted.Raw); err != nil {
+			t.Error(err)
+		}
+	})
+	t.Run("invalid-der", func(t *testing.T) {
+		if err := validateCertificate(validate, []byte{0xde, 0xad, 0xbe, 0xef}); err == nil {
+			t.Error("expected error")
+		}
+	})
+}
+
+func TestNewUserTokenSignature(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	serverCert, serverNonce := []byte{0xde, 0xad}, []byte{0xbe, 0xef}
+
+	sig, err := NewUserTokenSignature("http://opcfoundation.org/UA/SecurityPolicy#Basic256Sha256", key, serverCert, serverNonce)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := sig.Algorithm.Get(); got != SignatureAlgorithmRSASHA256 {
+		t.Errorf("Algorithm doesn't match. Want: %s, Got: %s", SignatureAlgorithmRSASHA256, got)
+	}
+	h := sha256.Sum256([]byte{0xde, 0xad, 0xbe, 0xef})
+	if err := rsa.VerifyPKCS1v15(&key.PublicKey, crypto.SHA256, h[:], sig.Signature.Get()); err != nil {
+		t.Errorf("invalid signature: %s", err)
+	}
+
+	sig, err = NewUserTokenSignature("http://opcfoundation.org/UA/SecurityPolicy#Aes256_Sha256_RsaPss", key, serverCert, serverNonce)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := sig.Algorithm.Get(); got != SignatureAlgorithmRSAPSSSHA256 {
+		t.Errorf("Algorithm doesn't match. Want: %s, Got: %s", SignatureAlgorithmRSAPSSSHA256, got)
+	}
+	opts := &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthEqualsHash, Hash: crypto.SHA256}
+	if err := rsa.VerifyPSS(&key.PublicKey, crypto.SHA256, h[:], sig.Signature.Get(), opts); err != nil {
+		t.Errorf("invalid signature: %s", err)
+	}
+
+	if _, err := NewUserTokenSignature("http://opcfoundation.org/UA/SecurityPolicy#None", key, serverCert, serverNonce); err == nil {
+		t.Error("expected error for SecurityPolicy None")
+	}
+}
+
+func TestGenerateCertificate(t *testing.T) {
+	certPEM, keyPEM, err := GenerateCertificate("urn:gopcua:client", "gopcua client", time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	block, _ := pem.Decode(certPEM)
+	if block == nil || block.Type != "CERTIFICATE" {
+		t.Fatalf("invalid certificate PEM: %s", certPEM)
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cert.URIs) != 1 || cert.URIs[0].String() != "urn:gopcua:client" {
+		t.Errorf("URIs doesn't match. Want: [urn:gopcua:client], Got: %v", cert.URIs)
+	}
+	if got := cert.Subject.CommonName; got != "gopcua client" {
+		t.Errorf("CommonName doesn't match. Want: gopcua client, Got: %s", got)
+	}
+	if len(cert.ExtKeyUsage) != 2 {
+		t.Errorf("ExtKeyUsage should have ServerAuth and ClientAuth, got %v", cert.ExtKeyUsage)
+	}
+
+	block, _ = pem.Decode(keyPEM)
+	if block == nil || block.Type != "RSA PRIVATE KEY" {
+		t.Fatalf("invalid key PEM: %s", keyPEM)
+	}
+	key, err := x509.ParsePKCS1PrivateKey(block.Bytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pub, ok := cert.PublicKey.(*rsa.PublicKey); !ok || pub.N.Cmp(key.N) != 0 {
+		t.Error("public key of the certificate doesn't match the private key")
+	}
+
+	dir, err := ioutil.TempDir("", "gopcua-trust")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	if err := ioutil.WriteFile(filepath.Join(dir, "client.pem"), certPEM, 0600); err != nil {
+		t.Fatal(err)
+	}
+	validate, err := NewTrustListValidator(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := validateCertificate(validate, cert.Raw); err != nil {
+		t.Errorf("expected to be accepted, got %v", err)
+	}
+
+	if _, _, err := GenerateCertificate(":invalid", "gopcua client", time.Hour); err == nil {
+		t.Error("expected error for invalid ApplicationURI")
+	}
+	if _, _, err := GenerateCertificate("urn:gopcua:client", "gopcua client", 0); err == nil {
+		t.Error("expected error for zero validFor")
+	}
+	if _, _, err := GenerateCertificate("urn:gopcua:client", "gopcua client", -time.Hour); err == nil {
+		t.Error("expected error for negative validFor")
+	}
+}
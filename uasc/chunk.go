@@ -0,0 +1,129 @@
+// Copyright 2018 gopcua authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package uasc
+
+import (
+	"encoding/binary"
+
+	"github.com/wmnsk/gopcua/errors"
+)
+
+// chunkBuffer accumulates the bodies of intermediate MessageChunks of MSG
+// per RequestID until the final chunk arrives.
+//
+// Specification: Part 6, 6.7.2
+type chunkBuffer struct {
+	// 0 means no limit.
+	maxChunkCount uint32
+	// the total size of the bodies of a message, which is always limited.
+	maxMessageSize uint32
+	bodies         map[uint32][]byte
+	counts         map[uint32]uint32
+}
+
+// DefaultMaxMessageSize is the limit of the size of a reassembled message
+// used when MaxMessageSize in Config is 0.
+const DefaultMaxMessageSize = 16 * 1024 * 1024
+
+func newChunkBuffer(maxChunkCount, maxMessageSize uint32) *chunkBuffer {
+	if maxMessageSize == 0 {
+		maxMessageSize = DefaultMaxMessageSize
+	}
+	return &chunkBuffer{
+		maxChunkCount:  maxChunkCount,
+		maxMessageSize: maxMessageSize,
+		bodies:         map[uint32][]byte{},
+		counts:         map[uint32]uint32{},
+	}
+}
+
+// add adds a MessageChunk to the buffer.
+//
+// If b is the final chunk, add returns the whole message in the form of a single
+// final chunk that can be decoded with Decode. If b is an intermediate chunk, add
+// returns nil and the caller should wait for the subsequent chunks.
+//
+// Chunks other than MSG are returned as they are, as OPN and CLO are never split.
+func (c *chunkBuffer) add(b []byte) ([]byte, error) {
+	h, err := DecodeHeader(b)
+	if err != nil {
+		return nil, err
+	}
+	if h.MessageTypeValue() != MessageTypeMessage {
+		return b, nil
+	}
+	if int(h.MessageSize) >= 12 && int(h.MessageSize) < len(b) {
+		b = b[:h.MessageSize]
+		h.Payload = b[12:]
+	}
+
+	sym, err := DecodeSymmetricSecurityHeader(h.Payload)
+	if err != nil {
+		return nil, err
+	}
+	seq, err := DecodeSequenceHeader(sym.Payload)
+	if err != nil {
+		return nil, err
+	}
+	reqID := seq.RequestID
+
+	switch h.ChunkTypeValue() {
+	case ChunkTypeIntermediate:
+		c.counts[reqID]++
+		// the final chunk is yet to come, so the intermediate ones should be less than the limit.
+		if c.maxChunkCount > 0 && c.counts[reqID] >= c.maxChunkCount {
+			c.discard(reqID)
+			return nil, ErrResponseTooLarge
+		}
+		if c.exceedsMaxMessageSize(reqID, seq.Payload) {
+			c.discard(reqID)
+			return nil, ErrResponseTooLarge
+		}
+		c.bodies[reqID] = append(c.bodies[reqID], seq.Payload...)
+		return nil, nil
+	case ChunkTypeError:
+		c.discard(reqID)
+		return nil, ErrMessageAborted
+	default:
+		body, ok := c.bodies[reqID]
+		if !ok {
+			return b, nil
+		}
+		c.discard(reqID)
+		if uint64(len(body))+uint64(len(seq.Payload)) > uint64(c.maxMessageSize) {
+			return nil, ErrResponseTooLarge
+		}
+
+		// reuse the headers of the final chunk, and replace the body with the whole one.
+		hdrLen := len(b) - len(seq.Payload)
+		msg := make([]byte, hdrLen+len(body)+len(seq.Payload))
+		copy(msg, b[:hdrLen])
+		copy(msg[hdrLen:], body)
+		copy(msg[hdrLen+len(body):], seq.Payload)
+		binary.LittleEndian.PutUint32(msg[4:8], uint32(len(msg)))
+
+		return msg, nil
+	}
+}
+
+// exceedsMaxMessageSize checks if the bodies of reqID get larger than maxMessageSize
+// by adding body. It is checked before appending, so that the memory is never
+// allocated for a message that is too large.
+func (c *chunkBuffer) exceedsMaxMessageSize(reqID uint32, body []byte) bool {
+	return uint64(len(c.bodies[reqID]))+uint64(len(body)) > uint64(c.maxMessageSize)
+}
+
+func (c *chunkBuffer) discard(reqID uint32) {
+	delete(c.bodies, reqID)
+	delete(c.counts, reqID)
+}
+
+// Errors returned while reassembling MessageChunks.
+var (
+	// ErrResponseTooLarge corresponds to BadResponseTooLarge, which indicates
+	// the message consists of more chunks than MaxChunkCount, or is larger than MaxMessageSize.
+	ErrResponseTooLarge = errors.New("response too large: exceeded MaxChunkCount or MaxMessageSize")
+	ErrMessageAborted   = errors.New("message aborted by the sender")
+)
@@ -0,0 +1,136 @@
+// Copyright 2018 gopcua authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package uasc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/wmnsk/gopcua/services"
+)
+
+func newTestChunk(t *testing.T, chunkType string, seq uint32, body []byte) []byte {
+	t.Helper()
+
+	cfg := &Config{SecureChannelID: 1, RequestID: 1, SequenceNumber: seq, SecurityTokenID: 1}
+	m := New(nil, cfg)
+	m.Header.ChunkType = chunkType[0]
+	m.MessageSize += uint32(len(body))
+
+	b, err := m.Serialize()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return append(b, body...)
+}
+
+func TestChunkBuffer(t *testing.T) {
+	srv := services.NewGetEndpointsRequest(
+		time.Date(2018, time.August, 10, 23, 0, 0, 0, time.UTC),
+		1, 0, 0, "", "opc.tcp://wow.its.easy:11111/UA/Server",
+		nil, nil,
+	)
+	body, err := srv.Serialize()
+	if err != nil {
+		t.Fatal(err)
+	}
+	whole := newTestChunk(t, ChunkTypeFinal, 3, body)
+
+	t.Run("single", func(t *testing.T) {
+		c := newChunkBuffer(0, 0)
+		got, err := c.add(whole)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, whole); diff != "" {
+			t.Error(diff)
+		}
+	})
+	t.Run("reassemble", func(t *testing.T) {
+		c := newChunkBuffer(3, 0)
+		for i, chunk := range [][]byte{
+			newTestChunk(t, ChunkTypeIntermediate, 1, body[:10]),
+			newTestChunk(t, ChunkTypeIntermediate, 2, body[10:20]),
+		} {
+			got, err := c.add(chunk)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != nil {
+				t.Errorf("chunk %d: expected nil, got %x", i, got)
+			}
+		}
+
+		got, err := c.add(newTestChunk(t, ChunkTypeFinal, 3, body[20:]))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, whole); diff != "" {
+			t.Error(diff)
+		}
+
+		msg, err := Decode(got)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, ok := msg.Service.(*services.GetEndpointsRequest); !ok {
+			t.Errorf("unexpected service: %T", msg.Service)
+		}
+		if len(c.bodies) != 0 {
+			t.Errorf("buffer not cleared: %v", c.bodies)
+		}
+	})
+	t.Run("too-many-chunks", func(t *testing.T) {
+		c := newChunkBuffer(2, 0)
+		if _, err := c.add(newTestChunk(t, ChunkTypeIntermediate, 1, body[:10])); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := c.add(newTestChunk(t, ChunkTypeIntermediate, 2, body[10:20])); err != ErrResponseTooLarge {
+			t.Errorf("expected %v, got %v", ErrResponseTooLarge, err)
+		}
+		if len(c.bodies) != 0 {
+			t.Errorf("buffer not cleared: %v", c.bodies)
+		}
+	})
+	t.Run("too-large", func(t *testing.T) {
+		// no limit on the number of chunks, only on the size.
+		c := newChunkBuffer(0, 15)
+		if _, err := c.add(newTestChunk(t, ChunkTypeIntermediate, 1, body[:10])); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := c.add(newTestChunk(t, ChunkTypeIntermediate, 2, body[10:20])); err != ErrResponseTooLarge {
+			t.Errorf("expected %v, got %v", ErrResponseTooLarge, err)
+		}
+		if len(c.bodies) != 0 {
+			t.Errorf("buffer not cleared: %v", c.bodies)
+		}
+	})
+	t.Run("too-large-final", func(t *testing.T) {
+		c := newChunkBuffer(0, 25)
+		if _, err := c.add(newTestChunk(t, ChunkTypeIntermediate, 1, body[:20])); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := c.add(newTestChunk(t, ChunkTypeFinal, 2, body[20:])); err != ErrResponseTooLarge {
+			t.Errorf("expected %v, got %v", ErrResponseTooLarge, err)
+		}
+		if len(c.bodies) != 0 {
+			t.Errorf("buffer not cleared: %v", c.bodies)
+		}
+	})
+	t.Run("abort", func(t *testing.T) {
+		c := newChunkBuffer(0, 0)
+		if _, err := c.add(newTestChunk(t, ChunkTypeIntermediate, 1, body[:10])); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := c.add(newTestChunk(t, ChunkTypeError, 2, nil)); err != ErrMessageAborted {
+			t.Errorf("expected %v, got %v", ErrMessageAborted, err)
+		}
+		if len(c.bodies) != 0 {
+			t.Errorf("buffer not cleared: %v", c.bodies)
+		}
+	})
+}
@@ -41,8 +41,9 @@ func openSecureChannel(ctx context.Context, transportConn net.Conn, cfg *Config,
 		state:     cliStateSecureChannelClosed,
 		stateChan: make(chan secChanState),
 		lenChan:   make(chan int),
-		errChan:   make(chan error),
+		errChan:   make(chan error, 1),
 		rcvBuf:    make([]byte, 0xffff),
+		chunks:    newChunkBuffer(cfg.MaxChunkCount, cfg.MaxMessageSize),
 	}
 
 	if err := secChan.OpenSecureChannelRequest(secMode, lifetime, nonce); err != nil {
@@ -18,6 +18,20 @@ type Config struct {
 	RequestID         uint32
 	SecurityTokenID   uint32
 	SequenceNumber    uint32
+
+	// MaxChunkCount is the maximum number of chunks in a received message.
+	// It should be set to the value negotiated in UACP. 0 means no limit.
+	MaxChunkCount uint32
+
+	// MaxMessageSize is the maximum size of the body of a received message after
+	// reassembling the chunks. It should be set to the value negotiated in UACP.
+	// 0 means DefaultMaxMessageSize, as the memory for reassembling is always limited.
+	MaxMessageSize uint32
+
+	// ServerCertificateValidator is called with the certificate the server sent
+	// in OpenSecureChannelResponse. If it returns an error, the SecureChannel is
+	// not opened. The certificate is not validated if nil.
+	ServerCertificateValidator CertificateValidator
 }
 
 // NewConfig creates a new Config.
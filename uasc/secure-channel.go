@@ -8,6 +8,7 @@ import (
 	"context"
 	"io"
 	"net"
+	"sync"
 	"time"
 
 	"github.com/wmnsk/gopcua/errors"
@@ -23,13 +24,20 @@ type SecureChannel struct {
 	lowerConn      net.Conn
 	cfg            *Config
 	reqHandle      uint32
+	rcvSeqNum      uint32
 	rcvBuf, sndBuf []byte
+	chunks         *chunkBuffer
 	state          secChanState
 	stateChan      chan secChanState
 	lenChan        chan int
 	errChan        chan error
+	closeOnce      sync.Once
+	closeErr       error
 }
 
+// closeTimeout is the time limit to send CloseSecureChannelRequest in Close.
+const closeTimeout = time.Second
+
 // Read reads data from the connection.
 // Read can be made to time out and return an Error with Timeout() == true
 // after a fixed time limit; see SetDeadline and SetReadDeadline.
@@ -102,7 +110,7 @@ func (s *SecureChannel) WriteService(b []byte) (n int, err error) {
 	case e := <-s.errChan:
 		return 0, e
 	default:
-		s.cfg.SequenceNumber++
+		s.cfg.SequenceNumber = nextSequenceNumber(s.cfg.SequenceNumber)
 
 		msg := New(nil, s.cfg)
 		msg.MessageSize += uint32(len(b))
@@ -123,23 +131,45 @@ func (s *SecureChannel) WriteService(b []byte) (n int, err error) {
 // Close closes the connection.
 // Any blocked Read or Write operations will be unblocked and return errors.
 //
-// Before closing, client sends CloseSecureChannelRequest, but it does not care the result of that request.
+// Before closing, client sends CloseSecureChannelRequest if the SecureChannel is opened,
+// but it does not wait for the result of that request. The request is given up after
+// closeTimeout so that Close does not hang on a dead connection. In any case the
+// transport connection is closed, and the error in sending the request is returned if any.
 func (s *SecureChannel) Close() error {
+	s.closeOnce.Do(func() {
+		s.closeErr = s.close()
+	})
+	return s.closeErr
+}
+
+func (s *SecureChannel) close() error {
+	var err error
+
+	// no one may be waiting for the state change at this point, so the state
+	// is updated without notifying it to stateChan.
 	switch s.state {
-	case cliStateSecureChannelClosed, cliStateOpenSecureChannelSent, cliStateSecureChannelOpened:
-		s.CloseSecureChannelRequest()
-		s.updateState(cliStateSecureChannelClosed)
+	case cliStateSecureChannelOpened:
+		if err = s.lowerConn.SetWriteDeadline(time.Now().Add(closeTimeout)); err == nil {
+			err = s.CloseSecureChannelRequest()
+		}
+		s.state = cliStateSecureChannelClosed
+	case cliStateSecureChannelClosed, cliStateOpenSecureChannelSent, cliStateCloseSecureChannelSent:
+		s.state = cliStateSecureChannelClosed
 	case srvStateCloseSecureChannelSent, srvStateSecureChannelOpened:
-		s.updateState(srvStateSecureChannelClosed)
+		s.state = srvStateSecureChannelClosed
 	}
 
 	s.cfg.SequenceNumber = 0
+	s.rcvSeqNum = 0
 	s.reqHandle = 0
 	close(s.errChan)
 	close(s.lenChan)
 	close(s.stateChan)
 
-	return s.lowerConn.Close()
+	if cerr := s.lowerConn.Close(); err == nil {
+		err = cerr
+	}
+	return err
 }
 
 // LocalAddr returns the local network address.
@@ -281,12 +311,47 @@ func (s *SecureChannel) monitorMessages(ctx context.Context) {
 				if err == io.EOF {
 					continue
 				}
-				s.Close()
+				return
 			}
 			if n == 0 {
 				continue
 			}
 
+			// every chunk should have the SequenceNumber next to the previous one.
+			// the chunks undecodable as UASC are left to Decode below.
+			if seq, err := decodeSequenceNumber(s.rcvBuf[:n]); err == nil {
+				// the SecureChannel should be closed, as the messages may be replayed
+				// or lost. The error is not to block even if no one is reading it,
+				// and the deferred Close closes the SecureChannel.
+				if !validSequenceNumber(s.rcvSeqNum, seq) {
+					select {
+					case s.errChan <- ErrSecurityChecksFailed:
+					default:
+					}
+					return
+				}
+				s.rcvSeqNum = seq
+			}
+
+			// accumulate the intermediate chunks until the final one arrives.
+			b, err := s.chunks.add(s.rcvBuf[:n])
+			switch err {
+			case nil:
+			case ErrResponseTooLarge, ErrMessageAborted:
+				s.errChan <- err
+				continue
+			default:
+				// leave it to Decode below.
+				b = s.rcvBuf[:n]
+			}
+			if b == nil {
+				continue
+			}
+			if len(b) > len(s.rcvBuf) {
+				s.rcvBuf = make([]byte, len(b))
+			}
+			n = copy(s.rcvBuf, b)
+
 			msg, err := Decode(s.rcvBuf[:n])
 			if err != nil {
 				// pass to the user if msg is undecodable as UASC.
@@ -299,11 +364,13 @@ func (s *SecureChannel) monitorMessages(ctx context.Context) {
 			case *services.OpenSecureChannelRequest:
 				s.handleOpenSecureChannelRequest(m)
 			case *services.OpenSecureChannelResponse:
-				s.handleOpenSecureChannelResponse(m)
+				s.handleOpenSecureChannelResponse(m, msg.AsymmetricSecurityHeader.SenderCertificate.Get())
 			case *services.CloseSecureChannelRequest:
 				s.handleCloseSecureChannelRequest(m)
 			case *services.CloseSecureChannelResponse:
 				s.handleCloseSecureChannelResponse(m)
+			case *services.ServiceFault:
+				s.handleServiceFault(m, n)
 			default:
 				// pass to the user if type of msg is unknown.
 				if s.state == cliStateSecureChannelOpened || s.state == srvStateSecureChannelOpened {
@@ -314,6 +381,17 @@ func (s *SecureChannel) monitorMessages(ctx context.Context) {
 	}
 }
 
+func (s *SecureChannel) handleServiceFault(f *services.ServiceFault, n int) {
+	switch s.state {
+	// client gives up opening, as the server rejected OpenSecureChannelRequest.
+	case cliStateOpenSecureChannelSent:
+		s.errChan <- f
+	// pass to the user as the response to the request the user sent.
+	case cliStateSecureChannelOpened, srvStateSecureChannelOpened:
+		s.notifyLength(n)
+	}
+}
+
 func (s *SecureChannel) handleOpenSecureChannelRequest(o *services.OpenSecureChannelRequest) {
 	switch s.state {
 	// if state is closed, server accepts OpenSecureChannelRequest.
@@ -346,12 +424,18 @@ func (s *SecureChannel) handleOpenSecureChannelRequest(o *services.OpenSecureCha
 	}
 }
 
-func (s *SecureChannel) handleOpenSecureChannelResponse(o *services.OpenSecureChannelResponse) {
+func (s *SecureChannel) handleOpenSecureChannelResponse(o *services.OpenSecureChannelResponse, cert []byte) {
 	switch s.state {
 	// client accepts OpenSecureChannelResponse only after sending OpenSecureChannelRequest.
 	case cliStateOpenSecureChannelSent:
 		switch o.ServiceResult {
 		case 0: // Good
+			// abort the handshake if the server certificate is rejected.
+			if err := validateCertificate(s.cfg.ServerCertificateValidator, cert); err != nil {
+				s.errChan <- err
+				s.updateState(cliStateSecureChannelClosed)
+				return
+			}
 			s.cfg.SecureChannelID = o.SecurityToken.ChannelID
 			s.cfg.SecurityTokenID = o.SecurityToken.TokenID
 			s.updateState(cliStateSecureChannelOpened)
@@ -411,7 +495,7 @@ func (s *SecureChannel) handleCloseSecureChannelResponse(c *services.CloseSecure
 
 // OpenSecureChannelRequest sends OpenSecureChannelRequest on top of UASC to Conn.
 func (s *SecureChannel) OpenSecureChannelRequest(secMode, lifetime uint32, nonce []byte) error {
-	s.cfg.SequenceNumber++
+	s.cfg.SequenceNumber = nextSequenceNumber(s.cfg.SequenceNumber)
 	s.reqHandle++
 	osc, err := New(
 		services.NewOpenSecureChannelRequest(
@@ -431,7 +515,7 @@ func (s *SecureChannel) OpenSecureChannelRequest(secMode, lifetime uint32, nonce
 
 // OpenSecureChannelResponse sends OpenSecureChannelResponse on top of UASC to Conn.
 func (s *SecureChannel) OpenSecureChannelResponse(code, token, lifetime uint32, nonce []byte) error {
-	s.cfg.SequenceNumber++
+	s.cfg.SequenceNumber = nextSequenceNumber(s.cfg.SequenceNumber)
 	osc, err := New(
 		services.NewOpenSecureChannelResponse(
 			time.Now(), s.reqHandle, code, services.NewNullDiagnosticInfo(),
@@ -452,7 +536,7 @@ func (s *SecureChannel) OpenSecureChannelResponse(code, token, lifetime uint32,
 
 // CloseSecureChannelRequest sends CloseSecureChannelRequest on top of UASC to Conn.
 func (s *SecureChannel) CloseSecureChannelRequest() error {
-	s.cfg.SequenceNumber++
+	s.cfg.SequenceNumber = nextSequenceNumber(s.cfg.SequenceNumber)
 	s.reqHandle++
 	csc, err := New(
 		services.NewCloseSecureChannelRequest(
@@ -472,7 +556,7 @@ func (s *SecureChannel) CloseSecureChannelRequest() error {
 
 // CloseSecureChannelResponse sends CloseSecureChannelResponse on top of UASC to Conn.
 func (s *SecureChannel) CloseSecureChannelResponse(code uint32) error {
-	s.cfg.SequenceNumber++
+	s.cfg.SequenceNumber = nextSequenceNumber(s.cfg.SequenceNumber)
 	csc, err := New(
 		services.NewCloseSecureChannelResponse(
 			time.Now(), s.reqHandle, code, services.NewNullDiagnosticInfo(), []string{""},
@@ -497,4 +581,8 @@ var (
 	ErrSecureChannelNotOpened  = errors.New("connection not established")
 	ErrSecurityModeUnsupported = errors.New("got request with unsupported SecurityMode")
 	ErrRejected                = errors.New("rejected by server")
+
+	// ErrSecurityChecksFailed corresponds to BadSecurityChecksFailed, which indicates
+	// the SequenceNumber of the received message is out of order or out of range.
+	ErrSecurityChecksFailed = errors.New("security checks failed: invalid SequenceNumber")
 )
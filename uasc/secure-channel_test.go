@@ -6,12 +6,14 @@ package uasc
 
 import (
 	"context"
+	"net"
 	"testing"
 	"time"
 
 	"github.com/google/go-cmp/cmp"
 
 	"github.com/wmnsk/gopcua/services"
+	"github.com/wmnsk/gopcua/status"
 	"github.com/wmnsk/gopcua/uacp"
 )
 
@@ -203,3 +205,159 @@ NEXT:
 		t.Error(diff)
 	}
 }
+
+func newTestSecureChannel(conn net.Conn) *SecureChannel {
+	return &SecureChannel{
+		lowerConn: conn,
+		cfg:       NewConfig(1, "http://opcfoundation.org/UA/SecurityPolicy#None", nil, nil, 0, 1),
+		state:     cliStateSecureChannelOpened,
+		stateChan: make(chan secChanState),
+		lenChan:   make(chan int),
+		errChan:   make(chan error, 1),
+	}
+}
+
+func TestClose(t *testing.T) {
+	t.Run("sends-clo", func(t *testing.T) {
+		cliConn, srvConn := net.Pipe()
+		defer srvConn.Close()
+
+		got := make(chan *Message, 1)
+		go func() {
+			buf := make([]byte, 1024)
+			n, err := srvConn.Read(buf)
+			if err != nil {
+				t.Error(err)
+				close(got)
+				return
+			}
+			msg, err := Decode(buf[:n])
+			if err != nil {
+				t.Error(err)
+			}
+			got <- msg
+		}()
+
+		s := newTestSecureChannel(cliConn)
+		if err := s.Close(); err != nil {
+			t.Fatal(err)
+		}
+		msg := <-got
+		if msg == nil {
+			t.Fatal("nothing received")
+		}
+		if msg.MessageTypeValue() != MessageTypeCloseSecureChannel {
+			t.Errorf("unexpected message type: %s", msg.MessageTypeValue())
+		}
+		if _, ok := msg.Service.(*services.CloseSecureChannelRequest); !ok {
+			t.Errorf("unexpected service: %T", msg.Service)
+		}
+
+		// closing twice should not panic and should return the same result.
+		if err := s.Close(); err != nil {
+			t.Error(err)
+		}
+	})
+	t.Run("dead-peer", func(t *testing.T) {
+		cliConn, srvConn := net.Pipe()
+		defer srvConn.Close()
+
+		// nobody reads from srvConn, so writing CloseSecureChannelRequest blocks.
+		s := newTestSecureChannel(cliConn)
+		start := time.Now()
+		err := s.Close()
+		if err == nil {
+			t.Error("expected error, got nil")
+		}
+		if d := time.Since(start); d > closeTimeout+time.Second {
+			t.Errorf("Close took too long: %s", d)
+		}
+		if _, err := cliConn.Write([]byte{0x00}); err == nil {
+			t.Error("transport connection is not closed")
+		}
+	})
+}
+
+func TestOpenSecureChannelServiceFault(t *testing.T) {
+	cliConn, srvConn := net.Pipe()
+	defer srvConn.Close()
+
+	go func() {
+		buf := make([]byte, 1024)
+		if _, err := srvConn.Read(buf); err != nil {
+			t.Error(err)
+			return
+		}
+
+		cfg := NewConfig(1, "http://opcfoundation.org/UA/SecurityPolicy#None", nil, nil, 1, 1)
+		cfg.SequenceNumber = 1
+		fault := services.NewServiceFault(time.Now(), 1, status.BadSecurityPolicyRejected, nil, nil)
+		b, err := New(fault, cfg).Serialize()
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if _, err := srvConn.Write(b); err != nil {
+			t.Error(err)
+		}
+	}()
+
+	cfg := NewConfig(0, "http://opcfoundation.org/UA/SecurityPolicy#None", nil, nil, 1, 0)
+	_, err := openSecureChannel(context.Background(), cliConn, cfg, services.SecModeNone, 6000000, nil, time.Second, 1)
+	f, ok := err.(*services.ServiceFault)
+	if !ok {
+		t.Fatalf("expected *services.ServiceFault, got %v", err)
+	}
+	if f.ServiceResult != status.BadSecurityPolicyRejected {
+		t.Errorf("ServiceResult doesn't match. Want: %x, Got: %x", status.BadSecurityPolicyRejected, f.ServiceResult)
+	}
+}
+
+func TestInvalidSequenceNumber(t *testing.T) {
+	cliConn, srvConn := net.Pipe()
+	defer srvConn.Close()
+
+	go func() {
+		cfg := NewConfig(1, "http://opcfoundation.org/UA/SecurityPolicy#None", nil, nil, 1, 1)
+		cfg.SequenceNumber = 3
+		fault := services.NewServiceFault(time.Now(), 1, status.BadInternalError, nil, nil)
+		b, err := New(fault, cfg).Serialize()
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if _, err := srvConn.Write(b); err != nil {
+			t.Error(err)
+			return
+		}
+
+		// drain CloseSecureChannelRequest sent on closing.
+		buf := make([]byte, 1024)
+		srvConn.Read(buf)
+	}()
+
+	s := newTestSecureChannel(cliConn)
+	s.rcvBuf = make([]byte, 0xffff)
+	s.chunks = newChunkBuffer(0, 0)
+	s.rcvSeqNum = 5
+
+	done := make(chan struct{})
+	go func() {
+		s.monitorMessages(context.Background())
+		close(done)
+	}()
+	<-s.stateChan
+
+	// nobody reads the error, which should not block monitorMessages.
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("monitorMessages did not return")
+	}
+	if err := <-s.errChan; err != ErrSecurityChecksFailed {
+		t.Errorf("expected %v, got %v", ErrSecurityChecksFailed, err)
+	}
+	if _, err := cliConn.Write([]byte{0x00}); err == nil {
+		t.Error("transport connection is not closed")
+	}
+}
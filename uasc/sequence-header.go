@@ -7,6 +7,7 @@ package uasc
 import (
 	"encoding/binary"
 	"fmt"
+	"math"
 
 	"github.com/wmnsk/gopcua/errors"
 )
@@ -84,3 +85,64 @@ func (s *SequenceHeader) String() string {
 		s.Payload,
 	)
 }
+
+// maxSequenceNumber is the value the SequenceNumber should exceed before wrapping
+// around. The first SequenceNumber after the wrap around should be less than 1024.
+//
+// Specification: Part 6, 6.7.2.4
+const maxSequenceNumber = math.MaxUint32 - 1024
+
+// nextSequenceNumber returns the SequenceNumber to be used after n.
+func nextSequenceNumber(n uint32) uint32 {
+	if n > maxSequenceNumber {
+		return 1
+	}
+	return n + 1
+}
+
+// validSequenceNumber checks if n is the valid SequenceNumber to be received after last.
+// Any value other than 0 is accepted for the first message, i.e. when last is 0.
+func validSequenceNumber(last, n uint32) bool {
+	switch {
+	case last == 0:
+		return n != 0
+	case last > maxSequenceNumber:
+		// the sender may wrap around at any time after exceeding the maximum.
+		if n > 0 && n < 1024 {
+			return true
+		}
+		return last < math.MaxUint32 && n == last+1
+	default:
+		return n == last+1
+	}
+}
+
+// decodeSequenceNumber returns the SequenceNumber in the MessageChunk b.
+func decodeSequenceNumber(b []byte) (uint32, error) {
+	h, err := DecodeHeader(b)
+	if err != nil {
+		return 0, err
+	}
+
+	var payload []byte
+	switch h.MessageTypeValue() {
+	case MessageTypeOpenSecureChannel:
+		a, err := DecodeAsymmetricSecurityHeader(h.Payload)
+		if err != nil {
+			return 0, err
+		}
+		payload = a.Payload
+	default:
+		s, err := DecodeSymmetricSecurityHeader(h.Payload)
+		if err != nil {
+			return 0, err
+		}
+		payload = s.Payload
+	}
+
+	seq, err := DecodeSequenceHeader(payload)
+	if err != nil {
+		return 0, err
+	}
+	return seq.SequenceNumber, nil
+}
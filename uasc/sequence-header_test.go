@@ -91,3 +91,48 @@ func TestSequenceHeaderLen(t *testing.T) {
 		}
 	}
 }
+
+func TestSequenceNumberWrapAround(t *testing.T) {
+	max := uint32(maxSequenceNumber)
+	var nextCases = []struct {
+		description string
+		current     uint32
+		next        uint32
+	}{
+		{"first", 0, 1},
+		{"normal", 100, 101},
+		{"maximum", max, max + 1},
+		{"wrap", max + 1, 1},
+	}
+	for _, c := range nextCases {
+		t.Run("next/"+c.description, func(t *testing.T) {
+			if got := nextSequenceNumber(c.current); got != c.next {
+				t.Errorf("next SequenceNumber doesn't match. Want: %d, Got: %d", c.next, got)
+			}
+		})
+	}
+
+	var validCases = []struct {
+		description string
+		last, n     uint32
+		valid       bool
+	}{
+		{"first", 0, 51, true},
+		{"first-zero", 0, 0, false},
+		{"next", 51, 52, true},
+		{"gap", 51, 53, false},
+		{"replayed", 51, 51, false},
+		{"wrap-too-early", max, 1, false},
+		{"beyond-maximum", max, max + 1, true},
+		{"wrap", max + 1, 1, true},
+		{"wrap-too-large", max + 1, 1024, false},
+		{"wrap-at-uint32-max", 0xffffffff, 1023, true},
+	}
+	for _, c := range validCases {
+		t.Run("valid/"+c.description, func(t *testing.T) {
+			if got := validSequenceNumber(c.last, c.n); got != c.valid {
+				t.Errorf("validity of %d after %d doesn't match. Want: %v, Got: %v", c.n, c.last, c.valid, got)
+			}
+		})
+	}
+}
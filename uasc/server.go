@@ -19,8 +19,9 @@ func ListenAndAcceptSecureChannel(ctx context.Context, transport net.Conn, cfg *
 		state:     srvStateSecureChannelClosed,
 		stateChan: make(chan secChanState),
 		lenChan:   make(chan int),
-		errChan:   make(chan error),
+		errChan:   make(chan error, 1),
 		rcvBuf:    make([]byte, 0xffff),
+		chunks:    newChunkBuffer(cfg.MaxChunkCount, cfg.MaxMessageSize),
 	}
 
 	var message *Message